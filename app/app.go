@@ -166,6 +166,10 @@ type App struct {
 	// upgradeHeightV2 is used as a coordination mechanism for the height-based
 	// upgrade from v1 to v2.
 	upgradeHeightV2 int64
+	// daWorkers is the maximum number of goroutines used to extend the data
+	// square and compute its data availability header. Zero uses the number of
+	// usable CPUs.
+	daWorkers int
 	// MsgGateKeeper is used to define which messages are accepted for a given
 	// app version.
 	MsgGateKeeper *ante.MsgVersioningGateKeeper
@@ -210,6 +214,7 @@ func New(
 		tkeys:             tkeys,
 		memKeys:           memKeys,
 		upgradeHeightV2:   upgradeHeightV2,
+		daWorkers:         cast.ToInt(appOpts.Get(FlagDAWorkers)),
 	}
 
 	app.ParamsKeeper = initParamsKeeper(appCodec, encodingConfig.Amino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
@@ -265,6 +270,9 @@ func New(
 		app.GetSubspace(blobstreamtypes.ModuleName),
 		&stakingKeeper,
 	)
+	// The attestations stream uses query contexts to read the attestations
+	// committed before a stream was opened.
+	app.BlobstreamKeeper.SetQueryContextProvider(app.CreateQueryContext)
 
 	// Register the staking hooks. NOTE: stakingKeeper is passed by reference
 	// above so that it will contain these hooks.
@@ -299,7 +307,7 @@ func New(
 		app.MsgServiceRouter(),
 	)
 
-	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...)
+	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...).WithPolicies(app.ParamPolicies()...)
 
 	// register the proposal types
 	govRouter := oldgovtypes.NewRouter()
@@ -392,6 +400,8 @@ func New(
 
 	app.QueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
 	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	app.QueryRouter().AddRoute(proof.MultiShareInclusionQueryPath, proof.QueryMultiShareInclusionProof)
+	app.QueryRouter().AddRoute(proof.NamespaceAbsenceQueryPath, proof.QueryNamespaceAbsenceProof)
 
 	app.manager.RegisterInvariants(&app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -474,6 +484,15 @@ func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 	return res
 }
 
+// Commit implements the ABCI interface. This method is a wrapper around
+// baseapp's Commit so that the blobstream attestations created in the block are
+// only streamed once the block is committed.
+func (app *App) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	app.BlobstreamKeeper.PublishCommittedAttestations()
+	return res
+}
+
 // migrateCommitStore tells the baseapp during a version upgrade, which stores to add and which
 // stores to remove
 func (app *App) migrateCommitStore(fromVersion, toVersion uint64) (baseapp.StoreMigrations, error) {
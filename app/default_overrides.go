@@ -298,3 +298,46 @@ func DefaultAppConfig() *serverconfig.Config {
 	cfg.MinGasPrices = fmt.Sprintf("%v%s", appconsts.DefaultMinGasPrice, BondDenom)
 	return cfg
 }
+
+// FlagDAWorkers is the app config key of the number of goroutines used to
+// erasure code the data square and compute its row and column roots in
+// PrepareProposal and ProcessProposal.
+const FlagDAWorkers = "da.workers"
+
+// CustomAppConfig extends the cosmos-sdk app config with the celestia-app
+// specific sections of app.toml.
+type CustomAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	DA DAConfig `mapstructure:"da"`
+}
+
+// DAConfig configures the computation of the extended data square and its data
+// availability header.
+type DAConfig struct {
+	// Workers is the maximum number of goroutines used to erasure code the
+	// data square and compute its roots. Zero uses the number of usable CPUs.
+	Workers int `mapstructure:"workers"`
+}
+
+// CustomAppConfigTemplate is the template of app.toml for CustomAppConfig.
+const CustomAppConfigTemplate = serverconfig.DefaultConfigTemplate + `
+###############################################################################
+###                    Data Availability Configuration                      ###
+###############################################################################
+
+[da]
+
+# Maximum number of goroutines used to erasure code the data square and compute
+# its row and column roots in PrepareProposal and ProcessProposal. Zero uses the
+# number of usable CPUs.
+workers = {{ .DA.Workers }}
+`
+
+// DefaultCustomAppConfig returns the default app config of celestia-app.
+func DefaultCustomAppConfig() CustomAppConfig {
+	return CustomAppConfig{
+		Config: *DefaultAppConfig(),
+		DA:     DAConfig{Workers: 0},
+	}
+}
@@ -1,7 +1,9 @@
 package app
 
 import (
+	"bytes"
 	"testing"
+	"text/template"
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
@@ -10,6 +12,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	icagenesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	tmcfg "github.com/tendermint/tendermint/config"
 )
 
@@ -66,6 +69,20 @@ func TestDefaultAppConfig(t *testing.T) {
 	assert.Equal(t, "0.002utia", cfg.MinGasPrices)
 }
 
+func TestCustomAppConfigTemplate(t *testing.T) {
+	cfg := DefaultCustomAppConfig()
+	assert.Zero(t, cfg.DA.Workers)
+	cfg.DA.Workers = 4
+
+	tmpl, err := template.New("appConfigFileTemplate").Parse(CustomAppConfigTemplate)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, cfg))
+	assert.Contains(t, buf.String(), "minimum-gas-prices = \"0.002utia\"")
+	assert.Contains(t, buf.String(), "[da]\n")
+	assert.Contains(t, buf.String(), "workers = 4\n")
+}
+
 func TestDefaultConsensusConfig(t *testing.T) {
 	got := DefaultConsensusConfig()
 
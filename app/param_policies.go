@@ -0,0 +1,59 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	blobstreamtypes "github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/celestiaorg/celestia-app/v2/x/paramfilter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinAttestationExpiryTime is the lowest attestation expiry time that
+	// governance can set. It leaves enough time to relay the attestations.
+	MinAttestationExpiryTime = 7 * 24 * time.Hour // 1 week
+	// MaxAttestationExpiryTime is the highest attestation expiry time that
+	// governance can set. It keeps the attestations state bounded.
+	MaxAttestationExpiryTime = 12 * 7 * 24 * time.Hour // 12 weeks
+)
+
+var (
+	// MinSignificantPowerDifferenceThreshold is the lowest significant power
+	// difference threshold that governance can set. It avoids creating a
+	// valset request for every small change in the validator set power.
+	MinSignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(1, 2) // 0.01
+	// MaxSignificantPowerDifferenceThreshold is the highest significant power
+	// difference threshold that governance can set. It is below one third so
+	// that the latest valset keeps a quorum of the current voting power.
+	MaxSignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(30, 2) // 0.30
+)
+
+// ParamPolicies returns the policies restricting the values that governance
+// proposals can set params to.
+func (app *App) ParamPolicies() []paramfilter.ParamPolicy {
+	return []paramfilter.ParamPolicy{
+		// blobstream.AttestationExpiryTime
+		paramfilter.NewParamPolicy(
+			blobstreamtypes.ModuleName,
+			string(blobstreamtypes.ParamsStoreKeyAttestationExpiryTime),
+			func(expiryTime time.Duration) error {
+				if expiryTime < MinAttestationExpiryTime || expiryTime > MaxAttestationExpiryTime {
+					return fmt.Errorf("attestation expiry time %v must be in [%v, %v]", expiryTime, MinAttestationExpiryTime, MaxAttestationExpiryTime)
+				}
+				return nil
+			},
+		),
+		// blobstream.SignificantPowerDifferenceThreshold
+		paramfilter.NewParamPolicy(
+			blobstreamtypes.ModuleName,
+			string(blobstreamtypes.ParamsStoreKeySignificantPowerDifferenceThreshold),
+			func(threshold sdk.Dec) error {
+				if threshold.LT(MinSignificantPowerDifferenceThreshold) || threshold.GT(MaxSignificantPowerDifferenceThreshold) {
+					return fmt.Errorf("significant power difference threshold %v must be in [%v, %v]", threshold, MinSignificantPowerDifferenceThreshold, MaxSignificantPowerDifferenceThreshold)
+				}
+				return nil
+			},
+		),
+	}
+}
@@ -55,10 +55,11 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		panic(err)
 	}
 
-	// Erasure encode the data square to create the extended data square (eds).
+	// Erasure encode the data square to compute the data availability header
+	// of the extended data square (eds).
 	// Note: uses the nmt wrapper to construct the tree. See
 	// pkg/wrapper/nmt_wrapper.go for more information.
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	dah, err := da.NewDataAvailabilityHeaderParallel(shares.ToBytes(dataSquare), app.daWorkers)
 	if err != nil {
 		app.Logger().Error(
 			"failure to erasure the data square while creating a proposal block",
@@ -68,16 +69,6 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		panic(err)
 	}
 
-	dah, err := da.NewDataAvailabilityHeader(eds)
-	if err != nil {
-		app.Logger().Error(
-			"failure to create new data availability header",
-			"error",
-			err.Error(),
-		)
-		panic(err)
-	}
-
 	// Tendermint doesn't need to use any of the erasure data because only the
 	// protobuf encoded version of the block data is gossiped. Therefore, the
 	// eds is not returned here.
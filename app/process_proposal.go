@@ -135,15 +135,9 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		return reject()
 	}
 
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	dah, err := da.NewDataAvailabilityHeaderParallel(shares.ToBytes(dataSquare), app.daWorkers)
 	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.Header, "failure to erasure the data square", err)
-		return reject()
-	}
-
-	dah, err := da.NewDataAvailabilityHeader(eds)
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.Header, "failure to create new data availability header", err)
+		logInvalidPropBlockError(app.Logger(), req.Header, "failure to erasure the data square or to create new data availability header", err)
 		return reject()
 	}
 	// by comparing the hashes we know the computed IndexWrappers (with the share indexes of the PFB's blobs)
@@ -0,0 +1,305 @@
+package app
+
+import (
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/go-square/blob"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// ShareKind describes what a share of the data square contains.
+type ShareKind string
+
+const (
+	ShareKindTx               ShareKind = "tx"
+	ShareKindPFB              ShareKind = "pfb"
+	ShareKindBlob             ShareKind = "blob"
+	ShareKindNamespacePadding ShareKind = "namespace_padding"
+	ShareKindReservedPadding  ShareKind = "reserved_padding"
+	ShareKindTailPadding      ShareKind = "tail_padding"
+)
+
+// ShareRange is a run of consecutive shares of the same kind and namespace in
+// the original data square. Start is inclusive and End exclusive, both are
+// share indexes in row-major order.
+type ShareRange struct {
+	Start     int       `json:"start"`
+	End       int       `json:"end"`
+	Kind      ShareKind `json:"kind"`
+	Namespace string    `json:"namespace"`
+}
+
+// TxLayout is the range of compact shares occupied by a transaction. The
+// ranges of consecutive transactions can overlap as they can share a share.
+type TxLayout struct {
+	Index int              `json:"index"`
+	Hash  tmbytes.HexBytes `json:"hash"`
+	Kind  ShareKind        `json:"kind"`
+	Start int              `json:"start"`
+	End   int              `json:"end"`
+}
+
+// BlobLayout is the range of shares occupied by a blob, and the PFB that paid
+// for it.
+type BlobLayout struct {
+	// TxIndex is the index in the block of the PFB that paid for the blob and
+	// BlobIndex the index of the blob in it.
+	TxIndex   int    `json:"tx_index"`
+	BlobIndex int    `json:"blob_index"`
+	Namespace string `json:"namespace"`
+	Size      int    `json:"size"`
+	Start     int    `json:"start"`
+	End       int    `json:"end"`
+}
+
+// SquareLayout describes how the transactions and blobs of a block are laid
+// out in its original data square.
+type SquareLayout struct {
+	Height     int64            `json:"height,omitempty"`
+	AppVersion uint64           `json:"app_version"`
+	SquareSize int              `json:"square_size"`
+	DataRoot   tmbytes.HexBytes `json:"data_root"`
+	Ranges     []ShareRange     `json:"ranges"`
+	Txs        []TxLayout       `json:"txs"`
+	Blobs      []BlobLayout     `json:"blobs"`
+}
+
+// NewSquareLayout extends the block data for the given app version using
+// ExtendBlock and returns the layout of its original data square.
+func NewSquareLayout(data coretypes.Data, appVersion uint64) (SquareLayout, error) {
+	eds, err := ExtendBlock(data, appVersion)
+	if err != nil {
+		return SquareLayout{}, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return SquareLayout{}, err
+	}
+	odsShares, err := shares.FromBytes(eds.FlattenedODS())
+	if err != nil {
+		return SquareLayout{}, err
+	}
+	layout := SquareLayout{
+		AppVersion: appVersion,
+		SquareSize: int(eds.Width() / 2),
+		DataRoot:   dah.Hash(),
+		Txs:        []TxLayout{},
+		Blobs:      []BlobLayout{},
+	}
+
+	for i := range odsShares {
+		kind, namespace, err := shareKind(&odsShares[i])
+		if err != nil {
+			return SquareLayout{}, fmt.Errorf("share %d: %w", i, err)
+		}
+		ns := hex.EncodeToString(namespace.Bytes())
+		if n := len(layout.Ranges); n > 0 && layout.Ranges[n-1].Kind == kind && layout.Ranges[n-1].Namespace == ns {
+			layout.Ranges[n-1].End = i + 1
+			continue
+		}
+		layout.Ranges = append(layout.Ranges, ShareRange{Start: i, End: i + 1, Kind: kind, Namespace: ns})
+	}
+
+	// The builder computes the ranges of the transactions and blobs the same
+	// way the square was constructed.
+	builder, err := square.NewBuilder(
+		appconsts.SquareSizeUpperBound(appVersion),
+		appconsts.SubtreeRootThreshold(appVersion),
+		data.Txs.ToSliceOfBytes()...,
+	)
+	if err != nil {
+		return SquareLayout{}, err
+	}
+	for i, tx := range data.Txs {
+		txRange, err := builder.FindTxShareRange(i)
+		if err != nil {
+			return SquareLayout{}, fmt.Errorf("tx %d: %w", i, err)
+		}
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+		kind := ShareKindTx
+		if isBlobTx {
+			kind = ShareKindPFB
+		}
+		layout.Txs = append(layout.Txs, TxLayout{
+			Index: i,
+			Hash:  tx.Hash(),
+			Kind:  kind,
+			Start: txRange.Start,
+			End:   txRange.End,
+		})
+		if !isBlobTx {
+			continue
+		}
+		for j, b := range blobTx.Blobs {
+			start, err := builder.FindBlobStartingIndex(i, j)
+			if err != nil {
+				return SquareLayout{}, fmt.Errorf("blob %d of tx %d: %w", j, i, err)
+			}
+			length, err := builder.BlobShareLength(i, j)
+			if err != nil {
+				return SquareLayout{}, fmt.Errorf("blob %d of tx %d: %w", j, i, err)
+			}
+			layout.Blobs = append(layout.Blobs, BlobLayout{
+				TxIndex:   i,
+				BlobIndex: j,
+				Namespace: hex.EncodeToString(b.Namespace().Bytes()),
+				Size:      len(b.Data),
+				Start:     start,
+				End:       start + length,
+			})
+		}
+	}
+	return layout, nil
+}
+
+// shareKind returns the kind and the namespace of a share.
+func shareKind(share *shares.Share) (ShareKind, appns.Namespace, error) {
+	namespace, err := share.Namespace()
+	if err != nil {
+		return "", appns.Namespace{}, err
+	}
+	switch {
+	case namespace.IsTx():
+		return ShareKindTx, namespace, nil
+	case namespace.IsPayForBlob():
+		return ShareKindPFB, namespace, nil
+	case namespace.IsPrimaryReservedPadding():
+		return ShareKindReservedPadding, namespace, nil
+	case namespace.IsTailPadding():
+		return ShareKindTailPadding, namespace, nil
+	}
+	isPadding, err := share.IsPadding()
+	if err != nil {
+		return "", appns.Namespace{}, err
+	}
+	if isPadding {
+		return ShareKindNamespacePadding, namespace, nil
+	}
+	return ShareKindBlob, namespace, nil
+}
+
+// shareCell is the content of a share in a grid rendering of the layout.
+type shareCell struct {
+	Index     int
+	Kind      ShareKind
+	Namespace string
+	// Blob is the index in SquareLayout.Blobs of the blob occupying the share,
+	// or -1.
+	Blob int
+}
+
+// cells returns the content of every share of the original data square.
+func (l SquareLayout) cells() []shareCell {
+	cells := make([]shareCell, l.SquareSize*l.SquareSize)
+	for _, r := range l.Ranges {
+		for i := r.Start; i < r.End && i < len(cells); i++ {
+			cells[i] = shareCell{Index: i, Kind: r.Kind, Namespace: r.Namespace, Blob: -1}
+		}
+	}
+	for b, blob := range l.Blobs {
+		for i := blob.Start; i < blob.End && i < len(cells); i++ {
+			cells[i].Blob = b
+		}
+	}
+	return cells
+}
+
+// blobSymbols are the symbols of the blobs in the ASCII grid. They are reused
+// when a square contains more blobs.
+const blobSymbols = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+var kindSymbols = map[ShareKind]byte{
+	ShareKindTx:               'T',
+	ShareKindPFB:              'P',
+	ShareKindBlob:             '?',
+	ShareKindNamespacePadding: '_',
+	ShareKindReservedPadding:  '-',
+	ShareKindTailPadding:      '.',
+}
+
+// WriteASCII writes the layout as a grid of one character per share followed
+// by a legend.
+func (l SquareLayout) WriteASCII(w io.Writer) error {
+	var sb strings.Builder
+	if l.Height != 0 {
+		fmt.Fprintf(&sb, "height %d, ", l.Height)
+	}
+	fmt.Fprintf(&sb, "app version %d, square size %d, data root %s\n\n", l.AppVersion, l.SquareSize, l.DataRoot)
+	for i, cell := range l.cells() {
+		symbol := kindSymbols[cell.Kind]
+		if cell.Kind == ShareKindBlob && cell.Blob >= 0 {
+			symbol = blobSymbols[cell.Blob%len(blobSymbols)]
+		}
+		sb.WriteByte(symbol)
+		if (i+1)%l.SquareSize == 0 {
+			sb.WriteByte('\n')
+		}
+	}
+	sb.WriteString("\nT tx  P pfb  _ namespace padding  - reserved padding  . tail padding\n")
+	for b, blob := range l.Blobs {
+		fmt.Fprintf(&sb, "%c blob %d of tx %d, namespace %s, %d bytes, shares [%d, %d)\n",
+			blobSymbols[b%len(blobSymbols)], blob.BlobIndex, blob.TxIndex, blob.Namespace, blob.Size, blob.Start, blob.End)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+var squareLayoutTemplate = template.Must(template.New("square").Funcs(template.FuncMap{
+	// blobHue spreads the colors of consecutive blobs around the color wheel.
+	"blobHue": func(blob int) int { return blob * 137 % 360 },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Square layout{{ if .Layout.Height }} at height {{ .Layout.Height }}{{ end }}</title>
+<style>
+body { font-family: monospace; }
+table { border-collapse: collapse; }
+td { width: 12px; height: 12px; padding: 0; border: 1px solid #fff; }
+.tx { background: #3b82f6; }
+.pfb { background: #8b5cf6; }
+.namespace_padding { background: #d1d5db; }
+.reserved_padding { background: #9ca3af; }
+.tail_padding { background: #f3f4f6; }
+</style>
+</head>
+<body>
+<p>{{ if .Layout.Height }}height {{ .Layout.Height }}, {{ end }}app version {{ .Layout.AppVersion }}, square size {{ .Layout.SquareSize }}, data root {{ .Layout.DataRoot }}</p>
+<table>
+{{- range .Rows }}
+<tr>{{ range . }}<td class="{{ .Kind }}"{{ if ge .Blob 0 }} style="background: hsl({{ blobHue .Blob }}, 70%, 55%)"{{ end }} title="share {{ .Index }}: {{ .Kind }} {{ .Namespace }}{{ if ge .Blob 0 }}{{ with index $.Layout.Blobs .Blob }}, blob {{ .BlobIndex }} of tx {{ .TxIndex }}{{ end }}{{ end }}"></td>{{ end }}</tr>
+{{- end }}
+</table>
+<table>
+{{- range .Layout.Blobs }}
+<tr><td style="width: auto">blob {{ .BlobIndex }} of tx {{ .TxIndex }}, namespace {{ .Namespace }}, {{ .Size }} bytes, shares [{{ .Start }}, {{ .End }})</td></tr>
+{{- end }}
+</table>
+</body>
+</html>
+`))
+
+// WriteHTML writes the layout as an HTML page with a grid of one cell per
+// share. The cells of each blob share a color and their titles describe the
+// share.
+func (l SquareLayout) WriteHTML(w io.Writer) error {
+	cells := l.cells()
+	rows := make([][]shareCell, 0, l.SquareSize)
+	for i := 0; i < len(cells); i += l.SquareSize {
+		rows = append(rows, cells[i:i+l.SquareSize])
+	}
+	return squareLayoutTemplate.Execute(w, struct {
+		Layout SquareLayout
+		Rows   [][]shareCell
+	}{l, rows})
+}
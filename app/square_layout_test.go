@@ -0,0 +1,123 @@
+package app_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+	"github.com/celestiaorg/go-square/blob"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestNewSquareLayout(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	rand := tmrand.NewRand()
+
+	txs := blobfactory.GenerateManyRawSendTxs(signer, 5)
+	txs = append(txs, blobfactory.RandBlobTxs(signer, rand, 4, 3, 2000)...)
+	data := coretypes.Data{Txs: txs}
+
+	layout, err := app.NewSquareLayout(data, appconsts.LatestVersion)
+	require.NoError(t, err)
+
+	eds, err := app.ExtendBlock(data, appconsts.LatestVersion)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	assert.Equal(t, dah.Hash(), []byte(layout.DataRoot))
+	assert.Equal(t, int(eds.Width()/2), layout.SquareSize)
+
+	// the ranges cover the square without gaps
+	next := 0
+	for _, r := range layout.Ranges {
+		assert.Equal(t, next, r.Start)
+		assert.Less(t, r.Start, r.End)
+		next = r.End
+	}
+	assert.Equal(t, layout.SquareSize*layout.SquareSize, next)
+	assert.Equal(t, app.ShareKindTx, layout.Ranges[0].Kind)
+	assert.Equal(t, hex.EncodeToString(appns.TxNamespace.Bytes()), layout.Ranges[0].Namespace)
+	assert.Equal(t, app.ShareKindPFB, layout.Ranges[1].Kind)
+	assert.Equal(t, app.ShareKindTailPadding, layout.Ranges[len(layout.Ranges)-1].Kind)
+
+	require.Len(t, layout.Txs, len(txs))
+	for i, tx := range layout.Txs {
+		assert.Equal(t, i, tx.Index)
+		assert.Equal(t, []byte(txs[i].Hash()), []byte(tx.Hash))
+		want := app.ShareKindTx
+		if i >= 5 {
+			want = app.ShareKindPFB
+		}
+		assert.Equal(t, want, tx.Kind)
+		assert.Equal(t, want, kindAt(t, layout, tx.Start))
+		assert.Equal(t, want, kindAt(t, layout, tx.End-1))
+	}
+
+	// every blob is owned by the PFB that paid for it and lies in a range of
+	// its namespace
+	require.Len(t, layout.Blobs, 4*3)
+	for _, b := range layout.Blobs {
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(txs[b.TxIndex])
+		require.True(t, isBlobTx)
+		assert.Equal(t, hex.EncodeToString(blobTx.Blobs[b.BlobIndex].Namespace().Bytes()), b.Namespace)
+		assert.Equal(t, 2000, b.Size)
+		for i := b.Start; i < b.End; i++ {
+			assert.Equal(t, app.ShareKindBlob, kindAt(t, layout, i))
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, layout.WriteASCII(&buf))
+	lines := strings.Split(buf.String(), "\n")
+	for _, line := range lines[2 : 2+layout.SquareSize] {
+		assert.Len(t, line, layout.SquareSize)
+	}
+	assert.True(t, strings.HasPrefix(lines[2], "T"))
+
+	buf.Reset()
+	require.NoError(t, layout.WriteHTML(&buf))
+	assert.Equal(t, layout.SquareSize*layout.SquareSize, strings.Count(buf.String(), "<td class="))
+
+	bz, err := json.Marshal(layout)
+	require.NoError(t, err)
+	var decoded app.SquareLayout
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	assert.Equal(t, layout, decoded)
+}
+
+func TestNewSquareLayoutEmptyBlock(t *testing.T) {
+	layout, err := app.NewSquareLayout(coretypes.Data{}, appconsts.LatestVersion)
+	require.NoError(t, err)
+	assert.Equal(t, 1, layout.SquareSize)
+	assert.Equal(t, []app.ShareRange{{
+		Start:     0,
+		End:       1,
+		Kind:      app.ShareKindTailPadding,
+		Namespace: hex.EncodeToString(appns.TailPaddingNamespace.Bytes()),
+	}}, layout.Ranges)
+	assert.Empty(t, layout.Txs)
+	assert.Empty(t, layout.Blobs)
+}
+
+// kindAt returns the kind of the share at index i of the layout.
+func kindAt(t *testing.T, layout app.SquareLayout, i int) app.ShareKind {
+	for _, r := range layout.Ranges {
+		if i >= r.Start && i < r.End {
+			return r.Kind
+		}
+	}
+	t.Fatalf("share %d is not in any range", i)
+	return ""
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/store"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	flagLocal  = "local"
+	flagFormat = "format"
+)
+
+// debugCommand returns the debug command of the SDK extended with the
+// celestia-app specific subcommands.
+func debugCommand() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(debugSquareCommand())
+	return cmd
+}
+
+func debugSquareCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "square <height>",
+		Short: "Print the layout of the data square of a block",
+		Long: "Print the layout of the data square of a block: the namespace of each range of shares, the shares of the transactions, PFBs and blobs, the padding, and the PFB that paid for each blob.\n" +
+			"The block is fetched from the node at --node, or read from the block store of the node in --home with --local. The node must be stopped to read its block store.\n" +
+			"The layout is printed as JSON, or as an ASCII or HTML grid of the shares of the original data square.",
+		Example: "celestia-appd debug square 1000 --format html > square.html",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %w", args[0], err)
+			}
+			format, err := cmd.Flags().GetString(flagFormat)
+			if err != nil {
+				return err
+			}
+			if format != "json" && format != "ascii" && format != "html" {
+				return fmt.Errorf("unsupported format %q: must be json, ascii or html", format)
+			}
+			local, err := cmd.Flags().GetBool(flagLocal)
+			if err != nil {
+				return err
+			}
+
+			var block *coretypes.Block
+			if local {
+				block, err = loadLocalBlock(cmd, height)
+			} else {
+				block, err = fetchBlock(cmd, height)
+			}
+			if err != nil {
+				return err
+			}
+
+			layout, err := app.NewSquareLayout(block.Data, block.Header.Version.App)
+			if err != nil {
+				return fmt.Errorf("computing the layout of the square at height %d: %w", height, err)
+			}
+			layout.Height = block.Height
+
+			out := cmd.OutOrStdout()
+			switch format {
+			case "json":
+				encoder := json.NewEncoder(out)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(layout)
+			case "html":
+				return layout.WriteHTML(out)
+			default:
+				return layout.WriteASCII(out)
+			}
+		},
+	}
+
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().Bool(flagLocal, false, "Read the block from the block store of the node in --home instead of over RPC")
+	cmd.Flags().String(flagFormat, "ascii", "Output format (json|ascii|html)")
+	return cmd
+}
+
+// fetchBlock fetches the block at height from the node over RPC.
+func fetchBlock(cmd *cobra.Command, height int64) (*coretypes.Block, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	res, err := node.Block(cmd.Context(), &height)
+	if err != nil {
+		return nil, fmt.Errorf("fetching block at height %d: %w", height, err)
+	}
+	return res.Block, nil
+}
+
+// loadLocalBlock reads the block at height from the block store of the node.
+func loadLocalBlock(cmd *cobra.Command, height int64) (*coretypes.Block, error) {
+	cfg := server.GetServerContextFromCmd(cmd).Config
+	db, err := dbm.NewDB("blockstore", dbm.BackendType(cfg.DBBackend), cfg.DBDir())
+	if err != nil {
+		return nil, fmt.Errorf("opening the block store: %w", err)
+	}
+	defer db.Close()
+
+	blockStore := store.NewBlockStore(db)
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found in the block store (base %d, height %d)", height, blockStore.Base(), blockStore.Height())
+	}
+	return block, nil
+}
@@ -16,13 +16,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/snapshot"
 	"github.com/cosmos/cosmos-sdk/server"
-	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
@@ -84,8 +82,8 @@ func NewRootCmd() *cobra.Command {
 			// Override the default tendermint config and app config for celestia-app
 			var (
 				tmCfg       = app.DefaultConsensusConfig()
-				appConfig   = app.DefaultAppConfig()
-				appTemplate = serverconfig.DefaultConfigTemplate
+				appConfig   = app.DefaultCustomAppConfig()
+				appTemplate = app.CustomAppConfigTemplate
 			)
 
 			err = server.InterceptConfigsPreRunHandler(cmd, appTemplate, appConfig, tmCfg)
@@ -132,7 +130,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig encoding.Config) {
 		genutilcli.GenTxCmd(app.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		tmcli.NewCompletionCmd(rootCmd, true),
-		debug.Cmd(),
+		debugCommand(),
 		config.Cmd(),
 		commands.CompactGoLevelDBCmd,
 		addrbookCommand(),
@@ -143,6 +141,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig encoding.Config) {
 		txCommand(),
 		keys.Commands(app.DefaultNodeHome),
 		bscmd.VerifyCmd(),
+		bscmd.ExportProofCmd(),
+		bscmd.ExportArchiveCmd(),
 		snapshot.Cmd(NewAppServer),
 	)
 
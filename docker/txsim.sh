@@ -14,8 +14,11 @@ SEND_AMOUNT=1000
 SEND_ITERATIONS=1000
 STAKE=0
 STAKE_VALUE=1000
+SCENARIO=""
+METRICS_ADDRESS=""
+REPORT_FILE=""
 
-while getopts "k:p:r:g:t:b:a:s:m:d:e:i:v:u:w:" opt; do
+while getopts "k:p:r:g:t:b:a:s:m:d:e:i:v:u:w:f:x:o:" opt; do
   case ${opt} in
     k )
       CREATE_KEY=$OPTARG
@@ -59,6 +62,15 @@ while getopts "k:p:r:g:t:b:a:s:m:d:e:i:v:u:w:" opt; do
     w )
       STAKE_VALUE=$OPTARG
       ;;
+    f )
+      SCENARIO=$OPTARG
+      ;;
+    x )
+      METRICS_ADDRESS=$OPTARG
+      ;;
+    o )
+      REPORT_FILE=$OPTARG
+      ;;
     \? )
       echo "Invalid option: $OPTARG" 1>&2
       exit 1
@@ -90,4 +102,7 @@ txsim --key-path $KEY_PATH \
  --send-amount $SEND_AMOUNT \
  --send-iterations $SEND_ITERATIONS \
  --stake $STAKE \
- --stake-value $STAKE_VALUE
+ --stake-value $STAKE_VALUE \
+ --scenario "$SCENARIO" \
+ --metrics-address "$METRICS_ADDRESS" \
+ --report-file "$REPORT_FILE"
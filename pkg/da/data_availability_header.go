@@ -18,6 +18,10 @@ import (
 )
 
 var (
+	// treePool recycles the trees that compute the row and column roots in
+	// NewDataAvailabilityHeaderParallel.
+	treePool = wrapper.NewTreePool()
+
 	maxExtendedSquareWidth = appconsts.DefaultSquareSizeUpperBound * 2
 	minExtendedSquareWidth = appconsts.MinSquareSize * 2
 )
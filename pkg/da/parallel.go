@@ -0,0 +1,131 @@
+package da
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/rsmt2d"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewDataAvailabilityHeaderParallel erasure codes the shares and computes the
+// data availability header of the extended data square, using at most workers
+// goroutines to encode the axes and compute their roots. If workers is not
+// positive, the number of usable CPUs is used. The header is identical to the
+// one returned by NewDataAvailabilityHeader for the square returned by
+// ExtendShares. The extended data square itself is not built, use ExtendShares
+// when it is needed.
+func NewDataAvailabilityHeaderParallel(s [][]byte, workers int) (DataAvailabilityHeader, error) {
+	// Check that the length of the square is a power of 2.
+	if !shares.IsPowerOfTwo(len(s)) {
+		return DataAvailabilityHeader{}, fmt.Errorf("number of shares is not a power of 2: got %d", len(s))
+	}
+	squareSize := SquareSize(len(s))
+	// Check that the shares fill the square, which is not the case of every
+	// power of 2.
+	if squareSize*squareSize != len(s) {
+		return DataAvailabilityHeader{}, fmt.Errorf("number of shares is not a square: got %d", len(s))
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	width := 2 * squareSize
+	codec := appconsts.DefaultCodec()
+	if len(s) > codec.MaxChunks() {
+		return DataAvailabilityHeader{}, fmt.Errorf("number of shares %d exceeds the maximum %d", len(s), codec.MaxChunks())
+	}
+
+	// extended holds the shares of the extended data square, indexed by row
+	// then column.
+	extended := make([][]byte, width*width)
+	for row := 0; row < squareSize; row++ {
+		copy(extended[row*width:row*width+squareSize], s[row*squareSize:(row+1)*squareSize])
+	}
+	cell := func(row, col int) *[]byte {
+		return &extended[row*width+col]
+	}
+	// extend encodes the first squareSize shares of an axis into the parity
+	// shares of its second half. at returns the cell of the i-th share of the
+	// axis.
+	extend := func(at func(i int) *[]byte) error {
+		original := make([][]byte, squareSize)
+		for i := range original {
+			original[i] = *at(i)
+		}
+		parity, err := codec.Encode(original)
+		if err != nil {
+			return err
+		}
+		for i, share := range parity {
+			*at(squareSize + i) = share
+		}
+		return nil
+	}
+
+	// Encode the rows of Q0 into Q1 and the columns of Q0 into Q2, then the
+	// rows of Q2 into Q3.
+	err := forEach(2*squareSize, workers, func(i int) error {
+		if i < squareSize {
+			return extend(func(col int) *[]byte { return cell(i, col) })
+		}
+		return extend(func(row int) *[]byte { return cell(row, i-squareSize) })
+	})
+	if err != nil {
+		return DataAvailabilityHeader{}, err
+	}
+	err = forEach(squareSize, workers, func(i int) error {
+		return extend(func(col int) *[]byte { return cell(squareSize+i, col) })
+	})
+	if err != nil {
+		return DataAvailabilityHeader{}, err
+	}
+
+	treeFn := treePool.NewConstructor(uint64(squareSize))
+	roots := make([][]byte, 2*width)
+	err = forEach(2*width, workers, func(i int) error {
+		axis, index := rsmt2d.Row, i
+		share := func(j int) []byte { return *cell(index, j) }
+		if i >= width {
+			axis, index = rsmt2d.Col, i-width
+			share = func(j int) []byte { return *cell(j, index) }
+		}
+		tree := treeFn(axis, uint(index))
+		for j := 0; j < width; j++ {
+			if err := tree.Push(share(j)); err != nil {
+				return err
+			}
+		}
+		root, err := tree.Root()
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+		return nil
+	})
+	if err != nil {
+		return DataAvailabilityHeader{}, err
+	}
+
+	dah := DataAvailabilityHeader{
+		RowRoots:    roots[:width],
+		ColumnRoots: roots[width:],
+	}
+	dah.Hash()
+	return dah, nil
+}
+
+// forEach calls fn for every index in [0, n) using at most workers goroutines,
+// and returns the first error.
+func forEach(n, workers int, fn func(i int) error) error {
+	var g errgroup.Group
+	g.SetLimit(workers)
+	for i := 0; i < n; i++ {
+		i := i
+		g.Go(func() error {
+			return fn(i)
+		})
+	}
+	return g.Wait()
+}
@@ -0,0 +1,70 @@
+package da
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewDataAvailabilityHeaderParallel checks that the parallel path produces
+// the same data availability header as the sequential one.
+func TestNewDataAvailabilityHeaderParallel(t *testing.T) {
+	for _, squareSize := range []int{1, 2, 4, 8, 16, 32, 64} {
+		s := testfactory.GenerateRandNamespacedRawData(squareSize * squareSize)
+		eds, err := ExtendShares(s)
+		require.NoError(t, err)
+		want, err := NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+
+		for _, workers := range []int{0, 1, 3, 16} {
+			t.Run(fmt.Sprintf("square size %d workers %d", squareSize, workers), func(t *testing.T) {
+				got, err := NewDataAvailabilityHeaderParallel(s, workers)
+				require.NoError(t, err)
+				assert.Equal(t, want, got)
+				assert.Equal(t, want.Hash(), got.Hash())
+			})
+		}
+	}
+}
+
+func TestNewDataAvailabilityHeaderParallelErrors(t *testing.T) {
+	_, err := NewDataAvailabilityHeaderParallel(testfactory.GenerateRandNamespacedRawData(3), 0)
+	assert.Error(t, err)
+
+	// 8 is a power of 2 but the shares don't fill a square
+	_, err = NewDataAvailabilityHeaderParallel(testfactory.GenerateRandNamespacedRawData(8), 0)
+	assert.Error(t, err)
+
+	// shares that are not ordered by namespace can't be pushed to the trees
+	s := testfactory.GenerateRandNamespacedRawData(4)
+	s[0], s[3] = s[3], s[0]
+	_, err = NewDataAvailabilityHeaderParallel(s, 0)
+	assert.Error(t, err)
+}
+
+func BenchmarkExtendShares(b *testing.B) {
+	for squareSize := 1; squareSize <= 128; squareSize *= 2 {
+		s := testfactory.GenerateRandNamespacedRawData(squareSize * squareSize)
+		b.Run(fmt.Sprintf("sequential square size %d", squareSize), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				eds, err := ExtendShares(s)
+				require.NoError(b, err)
+				_, err = NewDataAvailabilityHeader(eds)
+				require.NoError(b, err)
+			}
+		})
+		for _, workers := range []int{1, 4, 0} {
+			b.Run(fmt.Sprintf("parallel square size %d workers %d", squareSize, workers), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					_, err := NewDataAvailabilityHeaderParallel(s, workers)
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
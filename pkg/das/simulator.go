@@ -0,0 +1,282 @@
+// Package das simulates data availability sampling by light clients over an
+// extended data square and its data availability header. It is meant to study
+// the confidence that light clients get from sampling for different square
+// sizes and withholding strategies, without running celestia-node.
+package das
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// Config configures a sampling simulation.
+type Config struct {
+	// Clients is the number of simulated light clients.
+	Clients int
+	// SampleCounts are the numbers of samples per client for which the
+	// detection probability is reported.
+	SampleCounts []int
+	// Strategy selects the shares withheld by the block producer.
+	Strategy WithholdingStrategy
+	// CheckRecoverable repairs the square from the available shares to report
+	// whether the withholding makes it unrecoverable.
+	CheckRecoverable bool
+	// Seed seeds the randomness of the withholding and of the samples.
+	Seed int64
+}
+
+// ValidateBasic checks that the configuration can be simulated.
+func (c Config) ValidateBasic() error {
+	if c.Clients <= 0 {
+		return fmt.Errorf("number of clients must be positive, got %d", c.Clients)
+	}
+	if len(c.SampleCounts) == 0 {
+		return errors.New("no sample counts")
+	}
+	for _, samples := range c.SampleCounts {
+		if samples <= 0 {
+			return fmt.Errorf("sample count must be positive, got %d", samples)
+		}
+	}
+	if c.Strategy == nil {
+		return errors.New("no withholding strategy")
+	}
+	return nil
+}
+
+// Result is the outcome of a sampling simulation.
+type Result struct {
+	// SquareSize is the width of the original data square.
+	SquareSize int    `json:"square_size"`
+	Strategy   string `json:"strategy"`
+	// Withheld is the number of withheld shares of the extended data square.
+	Withheld int `json:"withheld"`
+	// Recoverable is nil if the recoverability of the square wasn't checked.
+	Recoverable *bool       `json:"recoverable,omitempty"`
+	Clients     int         `json:"clients"`
+	Detections  []Detection `json:"detections"`
+}
+
+// Detection is the detection probability of the withholding for a number of
+// samples per client.
+type Detection struct {
+	Samples int `json:"samples"`
+	// Detected is the number of clients that sampled a withheld share or
+	// failed to verify a sample.
+	Detected int `json:"detected"`
+	// Probability is the fraction of the clients that detected the
+	// withholding.
+	Probability float64 `json:"probability"`
+	// ExpectedProbability is the analytical probability that a client
+	// sampling distinct shares uniformly at random hits a withheld share.
+	ExpectedProbability float64 `json:"expected_probability"`
+}
+
+// Simulator simulates light clients sampling the shares of an extended data
+// square and verifying them against the row roots of its data availability
+// header.
+type Simulator struct {
+	eds *rsmt2d.ExtendedDataSquare
+	dah da.DataAvailabilityHeader
+	// trees are the row trees used to prove the samples, built on demand.
+	trees []*wrapper.ErasuredNamespacedMerkleTree
+}
+
+// NewSimulator returns a simulator for the extended data square, which must
+// match the data availability header.
+func NewSimulator(eds *rsmt2d.ExtendedDataSquare, dah da.DataAvailabilityHeader) (*Simulator, error) {
+	if err := dah.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if int(eds.Width()) != len(dah.RowRoots) {
+		return nil, fmt.Errorf("square width %d doesn't match the %d row roots of the header", eds.Width(), len(dah.RowRoots))
+	}
+	rowRoots, err := eds.RowRoots()
+	if err != nil {
+		return nil, err
+	}
+	for i, root := range rowRoots {
+		if !bytes.Equal(root, dah.RowRoots[i]) {
+			return nil, fmt.Errorf("row root %d of the square doesn't match the header", i)
+		}
+	}
+	return &Simulator{
+		eds:   eds,
+		dah:   dah,
+		trees: make([]*wrapper.ErasuredNamespacedMerkleTree, eds.Width()),
+	}, nil
+}
+
+// Run runs the simulation. The withheld shares are selected once, then each
+// client samples distinct random shares until it hits a withheld share or
+// reaches the largest sample count.
+func (s *Simulator) Run(cfg Config) (Result, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return Result{}, err
+	}
+	width := int(s.eds.Width())
+	total := width * width
+	rng := rand.New(rand.NewSource(cfg.Seed)) //nolint:gosec
+
+	withheld := cfg.Strategy.Withhold(width, rng)
+	withheldCount := 0
+	for _, row := range withheld {
+		for _, w := range row {
+			if w {
+				withheldCount++
+			}
+		}
+	}
+
+	result := Result{
+		SquareSize: width / 2,
+		Strategy:   cfg.Strategy.Name(),
+		Withheld:   withheldCount,
+		Clients:    cfg.Clients,
+	}
+	if cfg.CheckRecoverable {
+		recoverable, err := Recoverable(s.eds, s.dah, withheld)
+		if err != nil {
+			return Result{}, err
+		}
+		result.Recoverable = &recoverable
+	}
+
+	sampleCounts := append([]int(nil), cfg.SampleCounts...)
+	sort.Ints(sampleCounts)
+	maxSamples := sampleCounts[len(sampleCounts)-1]
+	if maxSamples > total {
+		maxSamples = total
+	}
+
+	// firstDetections[i] is the number of samples after which the client i
+	// detected the withholding, or 0 if it didn't.
+	firstDetections := make([]int, cfg.Clients)
+	for client := range firstDetections {
+		sampled := make(map[int]struct{}, maxSamples)
+		for samples := 1; samples <= maxSamples; samples++ {
+			cell := rng.Intn(total)
+			for _, ok := sampled[cell]; ok; _, ok = sampled[cell] {
+				cell = rng.Intn(total)
+			}
+			sampled[cell] = struct{}{}
+
+			row, col := cell/width, cell%width
+			if withheld[row][col] {
+				firstDetections[client] = samples
+				break
+			}
+			valid, err := s.verifySample(row, col)
+			if err != nil {
+				return Result{}, err
+			}
+			if !valid {
+				firstDetections[client] = samples
+				break
+			}
+		}
+	}
+
+	for _, samples := range sampleCounts {
+		detected := 0
+		for _, first := range firstDetections {
+			if first != 0 && first <= samples {
+				detected++
+			}
+		}
+		result.Detections = append(result.Detections, Detection{
+			Samples:             samples,
+			Detected:            detected,
+			Probability:         float64(detected) / float64(cfg.Clients),
+			ExpectedProbability: DetectionProbability(total, withheldCount, samples),
+		})
+	}
+	return result, nil
+}
+
+// verifySample proves the share of the cell to its row root and verifies the
+// proof against the data availability header, as a light client would.
+func (s *Simulator) verifySample(row, col int) (bool, error) {
+	tree, err := s.rowTree(row)
+	if err != nil {
+		return false, err
+	}
+	proof, err := tree.ProveRange(col, col+1)
+	if err != nil {
+		return false, err
+	}
+	share := s.eds.GetCell(uint(row), uint(col))
+	namespace := appns.ParitySharesNamespace.Bytes()
+	squareSize := int(s.eds.Width() / 2)
+	if row < squareSize && col < squareSize {
+		namespace = share[:appconsts.NamespaceSize]
+	}
+	return proof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, [][]byte{share}, s.dah.RowRoots[row]), nil
+}
+
+func (s *Simulator) rowTree(row int) (*wrapper.ErasuredNamespacedMerkleTree, error) {
+	if s.trees[row] != nil {
+		return s.trees[row], nil
+	}
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(s.eds.Width()/2), uint(row))
+	for _, share := range s.eds.Row(uint(row)) {
+		if err := tree.Push(share); err != nil {
+			return nil, err
+		}
+	}
+	s.trees[row] = &tree
+	return &tree, nil
+}
+
+// Recoverable returns whether the extended data square can be repaired from
+// its shares that are not withheld.
+func Recoverable(eds *rsmt2d.ExtendedDataSquare, dah da.DataAvailabilityHeader, withheld [][]bool) (bool, error) {
+	width := int(eds.Width())
+	flattened := eds.Flattened()
+	for row := 0; row < width; row++ {
+		for col := 0; col < width; col++ {
+			if withheld[row][col] {
+				flattened[row*width+col] = nil
+			}
+		}
+	}
+	square, err := rsmt2d.ImportExtendedDataSquare(flattened, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(width/2)))
+	if err != nil {
+		return false, err
+	}
+	err = square.Repair(dah.RowRoots, dah.ColumnRoots)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, rsmt2d.ErrUnrepairableDataSquare):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// DetectionProbability returns the probability that sampling the provided
+// number of distinct shares uniformly at random, out of total shares of which
+// withheld are withheld, hits at least one withheld share.
+func DetectionProbability(total, withheld, samples int) float64 {
+	if samples > total {
+		samples = total
+	}
+	missed := 1.0
+	for i := 0; i < samples; i++ {
+		missed *= float64(total-withheld-i) / float64(total-i)
+		if missed <= 0 {
+			return 1
+		}
+	}
+	return 1 - missed
+}
@@ -0,0 +1,148 @@
+package das_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/das"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSimulator(t *testing.T, squareSize int) (*das.Simulator, int) {
+	eds, err := da.ExtendShares(testfactory.GenerateRandNamespacedRawData(squareSize * squareSize))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	simulator, err := das.NewSimulator(eds, dah)
+	require.NoError(t, err)
+	return simulator, int(eds.Width())
+}
+
+func TestSimulatorRun(t *testing.T) {
+	simulator, width := newSimulator(t, 8)
+	total := width * width
+
+	type test struct {
+		name            string
+		strategy        das.WithholdingStrategy
+		withheld        int
+		recoverable     bool
+		alwaysDetected  bool
+		neverDetected   bool
+		samplesToDetect int
+	}
+	tests := []test{
+		{
+			name:          "no withholding is never detected",
+			strategy:      das.NoWithholding{},
+			withheld:      0,
+			recoverable:   true,
+			neverDetected: true,
+		},
+		{
+			name:        "minimal unrecoverable withholding",
+			strategy:    das.UnrecoverableWithholding{},
+			withheld:    (width/2 + 1) * (width/2 + 1),
+			recoverable: false,
+		},
+		{
+			name:        "withholding half of the rows is recoverable",
+			strategy:    das.RowWithholding{Rows: width / 2},
+			withheld:    width / 2 * width,
+			recoverable: true,
+		},
+		{
+			name:           "withholding all the rows is always detected",
+			strategy:       das.RowWithholding{Rows: width},
+			withheld:       total,
+			recoverable:    false,
+			alwaysDetected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := simulator.Run(das.Config{
+				Clients:          200,
+				SampleCounts:     []int{16, 1, 4},
+				Strategy:         tt.strategy,
+				CheckRecoverable: true,
+				Seed:             1,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, width/2, result.SquareSize)
+			assert.Equal(t, tt.withheld, result.Withheld)
+			require.NotNil(t, result.Recoverable)
+			assert.Equal(t, tt.recoverable, *result.Recoverable)
+			require.Len(t, result.Detections, 3)
+
+			// the detections are sorted by sample count and the detection
+			// probability grows with the number of samples
+			for i, detection := range result.Detections {
+				assert.Equal(t, []int{1, 4, 16}[i], detection.Samples)
+				assert.InDelta(t, das.DetectionProbability(total, tt.withheld, detection.Samples), detection.ExpectedProbability, 1e-9)
+				if i > 0 {
+					assert.GreaterOrEqual(t, detection.Detected, result.Detections[i-1].Detected)
+				}
+				if tt.neverDetected {
+					assert.Zero(t, detection.Detected)
+				}
+				if tt.alwaysDetected {
+					assert.Equal(t, 200, detection.Detected)
+				}
+				// the simulated probability is close to the analytical one
+				assert.InDelta(t, detection.ExpectedProbability, detection.Probability, 0.15)
+			}
+		})
+	}
+}
+
+func TestSimulatorRunIsDeterministic(t *testing.T) {
+	simulator, _ := newSimulator(t, 4)
+	cfg := das.Config{
+		Clients:      50,
+		SampleCounts: []int{1, 2, 3},
+		Strategy:     das.RandomWithholding{Fraction: 0.2},
+		Seed:         42,
+	}
+	first, err := simulator.Run(cfg)
+	require.NoError(t, err)
+	second, err := simulator.Run(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Nil(t, first.Recoverable)
+}
+
+func TestDetectionProbability(t *testing.T) {
+	assert.Equal(t, 0.0, das.DetectionProbability(100, 0, 10))
+	assert.Equal(t, 1.0, das.DetectionProbability(100, 100, 1))
+	assert.InDelta(t, 0.5, das.DetectionProbability(100, 50, 1), 1e-9)
+	// sampling more than the available shares always detects the withholding
+	assert.Equal(t, 1.0, das.DetectionProbability(100, 1, 100))
+}
+
+func TestParseStrategy(t *testing.T) {
+	for _, name := range []string{"none", "unrecoverable", "random:0.25", "rows:3"} {
+		strategy, err := das.ParseStrategy(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, strategy.Name())
+	}
+	for _, name := range []string{"", "random", "random:2", "rows:-1", "rows:x", "columns:1"} {
+		_, err := das.ParseStrategy(name)
+		assert.Error(t, err, name)
+	}
+
+	// the unrecoverable strategy withholds a (k+1)x(k+1) subsquare
+	cells := das.UnrecoverableWithholding{}.Withhold(8, rand.New(rand.NewSource(1)))
+	withheld := 0
+	for _, row := range cells {
+		for _, w := range row {
+			if w {
+				withheld++
+			}
+		}
+	}
+	assert.Equal(t, 25, withheld)
+}
@@ -0,0 +1,145 @@
+package das
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// WithholdingStrategy selects the shares of an extended data square that a
+// block producer withholds from the light clients.
+type WithholdingStrategy interface {
+	// Name returns a human readable description of the strategy.
+	Name() string
+	// Withhold returns the withheld cells of an extended data square of the
+	// provided width, indexed by row then column.
+	Withhold(width int, rng *rand.Rand) [][]bool
+}
+
+var (
+	_ WithholdingStrategy = NoWithholding{}
+	_ WithholdingStrategy = UnrecoverableWithholding{}
+	_ WithholdingStrategy = RandomWithholding{}
+	_ WithholdingStrategy = RowWithholding{}
+)
+
+// NoWithholding makes all the shares available.
+type NoWithholding struct{}
+
+func (NoWithholding) Name() string { return "none" }
+
+func (NoWithholding) Withhold(width int, _ *rand.Rand) [][]bool {
+	return newCells(width)
+}
+
+// UnrecoverableWithholding withholds the intersection of k+1 random rows and
+// k+1 random columns, where k is the width of the original data square. This
+// is the smallest set of shares that makes the square unrecoverable, and so
+// the hardest attack to detect by sampling.
+type UnrecoverableWithholding struct{}
+
+func (UnrecoverableWithholding) Name() string { return "unrecoverable" }
+
+func (UnrecoverableWithholding) Withhold(width int, rng *rand.Rand) [][]bool {
+	cells := newCells(width)
+	n := width/2 + 1
+	rows := rng.Perm(width)[:n]
+	cols := rng.Perm(width)[:n]
+	for _, row := range rows {
+		for _, col := range cols {
+			cells[row][col] = true
+		}
+	}
+	return cells
+}
+
+// RandomWithholding withholds each share independently with the probability
+// Fraction.
+type RandomWithholding struct {
+	Fraction float64
+}
+
+func (s RandomWithholding) Name() string {
+	return fmt.Sprintf("random:%g", s.Fraction)
+}
+
+func (s RandomWithholding) Withhold(width int, rng *rand.Rand) [][]bool {
+	cells := newCells(width)
+	for row := range cells {
+		for col := range cells[row] {
+			cells[row][col] = rng.Float64() < s.Fraction
+		}
+	}
+	return cells
+}
+
+// RowWithholding withholds Rows random rows of the extended data square. The
+// square is unrecoverable if more than half of the rows are withheld.
+type RowWithholding struct {
+	Rows int
+}
+
+func (s RowWithholding) Name() string {
+	return fmt.Sprintf("rows:%d", s.Rows)
+}
+
+func (s RowWithholding) Withhold(width int, rng *rand.Rand) [][]bool {
+	cells := newCells(width)
+	rows := s.Rows
+	if rows > width {
+		rows = width
+	}
+	for _, row := range rng.Perm(width)[:rows] {
+		for col := range cells[row] {
+			cells[row][col] = true
+		}
+	}
+	return cells
+}
+
+// ParseStrategy parses a withholding strategy from its name: "none",
+// "unrecoverable", "random:<fraction>" or "rows:<count>".
+func ParseStrategy(name string) (WithholdingStrategy, error) {
+	kind, arg, hasArg := strings.Cut(name, ":")
+	switch kind {
+	case "none":
+		return NoWithholding{}, nil
+	case "unrecoverable":
+		return UnrecoverableWithholding{}, nil
+	case "random":
+		if !hasArg {
+			return nil, fmt.Errorf("missing fraction in strategy %q", name)
+		}
+		fraction, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, err
+		}
+		if fraction < 0 || fraction > 1 {
+			return nil, fmt.Errorf("fraction %g must be in [0, 1]", fraction)
+		}
+		return RandomWithholding{Fraction: fraction}, nil
+	case "rows":
+		if !hasArg {
+			return nil, fmt.Errorf("missing row count in strategy %q", name)
+		}
+		rows, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, err
+		}
+		if rows < 0 {
+			return nil, fmt.Errorf("row count %d cannot be negative", rows)
+		}
+		return RowWithholding{Rows: rows}, nil
+	default:
+		return nil, fmt.Errorf("unknown withholding strategy %q", name)
+	}
+}
+
+func newCells(width int) [][]bool {
+	cells := make([][]bool, width)
+	for i := range cells {
+		cells[i] = make([]bool, width)
+	}
+	return cells
+}
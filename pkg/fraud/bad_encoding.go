@@ -0,0 +1,229 @@
+// Package fraud generates and verifies bad encoding fraud proofs.
+//
+// A bad encoding fraud proof shows that a row or column of an extended data
+// square committed to by a data availability header is not a valid Reed-Solomon
+// codeword. It contains half of the shares of the byzantine axis, each with an
+// NMT inclusion proof to the root of its orthogonal axis. A verifier decodes the
+// full axis from these shares, recomputes its root and checks that it doesn't
+// match the root committed to by the data availability header.
+package fraud
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// BadEncodingProof is a proof that a row or column of an extended data square
+// is not correctly erasure coded.
+type BadEncodingProof struct {
+	// Axis is the axis of the byzantine row or column.
+	Axis rsmt2d.Axis `json:"axis"`
+	// Index is the index of the byzantine row or column.
+	Index uint `json:"index"`
+	// Shares contains a share per position of the byzantine axis. Shares that
+	// aren't needed to decode the axis are nil.
+	Shares []*ShareWithProof `json:"shares"`
+}
+
+// ShareWithProof is a share of the byzantine axis along with its NMT inclusion
+// proof to the root of the orthogonal axis.
+type ShareWithProof struct {
+	Share []byte          `json:"share"`
+	Proof *proof.NMTProof `json:"proof"`
+}
+
+// FindByzantineData checks the extended data square against the data
+// availability header and returns the byzantine data found by rsmt2d, if any.
+// It returns nil if the square is correctly encoded and matches the header.
+func FindByzantineData(eds *rsmt2d.ExtendedDataSquare, dah da.DataAvailabilityHeader) (*rsmt2d.ErrByzantineData, error) {
+	if err := dah.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if int(eds.Width()) != len(dah.RowRoots) {
+		return nil, fmt.Errorf("square width %d doesn't match the %d row roots of the header", eds.Width(), len(dah.RowRoots))
+	}
+	// import the shares using the honest tree to check them independently of
+	// how the provided square was built.
+	imported, err := rsmt2d.ImportExtendedDataSquare(
+		eds.Flattened(),
+		appconsts.DefaultCodec(),
+		wrapper.NewConstructor(uint64(eds.Width()/2)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = imported.Repair(dah.RowRoots, dah.ColumnRoots)
+	var byzErr *rsmt2d.ErrByzantineData
+	if errors.As(err, &byzErr) {
+		return byzErr, nil
+	}
+	return nil, err
+}
+
+// NewBadEncodingProof returns a bad encoding fraud proof for the byzantine
+// axis reported by rsmt2d. The extended data square must be the one committed
+// to by the data availability header, as the share proofs are computed from
+// it.
+func NewBadEncodingProof(eds *rsmt2d.ExtendedDataSquare, byzErr *rsmt2d.ErrByzantineData) (*BadEncodingProof, error) {
+	if byzErr == nil {
+		return nil, errors.New("nil byzantine data")
+	}
+	width := eds.Width()
+	odsWidth := width / 2
+	if byzErr.Index >= width {
+		return nil, fmt.Errorf("byzantine %s %d outside of the square of width %d", byzErr.Axis, byzErr.Index, width)
+	}
+	if uint(len(byzErr.Shares)) != width {
+		return nil, fmt.Errorf("byzantine %s has %d shares, expected %d", byzErr.Axis, len(byzErr.Shares), width)
+	}
+
+	befp := &BadEncodingProof{
+		Axis:   byzErr.Axis,
+		Index:  byzErr.Index,
+		Shares: make([]*ShareWithProof, width),
+	}
+	// half of the shares are enough to decode the axis
+	included := uint(0)
+	for i, share := range byzErr.Shares {
+		if included == odsWidth {
+			break
+		}
+		if share == nil {
+			continue
+		}
+		row, col := cellPosition(byzErr.Axis, byzErr.Index, uint(i))
+		if !bytes.Equal(eds.GetCell(row, col), share) {
+			return nil, fmt.Errorf("share %d of the byzantine %s doesn't match the square", i, byzErr.Axis)
+		}
+
+		// prove the share to the root of its orthogonal axis
+		var orthogonal [][]byte
+		if byzErr.Axis == rsmt2d.Row {
+			orthogonal = eds.Col(uint(i))
+		} else {
+			orthogonal = eds.Row(uint(i))
+		}
+		tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(odsWidth), uint(i))
+		for _, s := range orthogonal {
+			if err := tree.Push(s); err != nil {
+				return nil, fmt.Errorf("building the tree of orthogonal axis %d: %w", i, err)
+			}
+		}
+		shareProof, err := tree.ProveRange(int(byzErr.Index), int(byzErr.Index)+1)
+		if err != nil {
+			return nil, err
+		}
+		befp.Shares[i] = &ShareWithProof{
+			Share: share,
+			Proof: &proof.NMTProof{
+				Start: int32(shareProof.Start()),
+				End:   int32(shareProof.End()),
+				Nodes: shareProof.Nodes(),
+			},
+		}
+		included++
+	}
+	if included < odsWidth {
+		return nil, fmt.Errorf("byzantine %s has %d shares, at least %d are needed to decode it", byzErr.Axis, included, odsWidth)
+	}
+	return befp, nil
+}
+
+// Validate verifies the bad encoding fraud proof against the data availability
+// header. It returns nil if the proof shows that the byzantine axis of the
+// header is not correctly erasure coded. Otherwise, it returns an error
+// describing why the proof is invalid.
+func (p *BadEncodingProof) Validate(dah da.DataAvailabilityHeader) error {
+	if err := dah.ValidateBasic(); err != nil {
+		return err
+	}
+	width := uint(len(dah.RowRoots))
+	odsWidth := width / 2
+	if p.Axis != rsmt2d.Row && p.Axis != rsmt2d.Col {
+		return fmt.Errorf("invalid axis %d", p.Axis)
+	}
+	if p.Index >= width {
+		return fmt.Errorf("byzantine %s %d outside of the square of width %d", p.Axis, p.Index, width)
+	}
+	if uint(len(p.Shares)) != width {
+		return fmt.Errorf("the number of shares %d must equal the square width %d", len(p.Shares), width)
+	}
+
+	axisRoots, orthogonalRoots := dah.RowRoots, dah.ColumnRoots
+	if p.Axis == rsmt2d.Col {
+		axisRoots, orthogonalRoots = dah.ColumnRoots, dah.RowRoots
+	}
+
+	// verify the inclusion of the shares in their orthogonal axes
+	shares := make([][]byte, width)
+	included := uint(0)
+	for i, share := range p.Shares {
+		if share == nil {
+			continue
+		}
+		if share.Proof == nil {
+			return fmt.Errorf("missing proof for share %d", i)
+		}
+		if len(share.Share) != appconsts.ShareSize {
+			return fmt.Errorf("share %d must be %d bytes, got %d", i, appconsts.ShareSize, len(share.Share))
+		}
+		if share.Proof.Start != int32(p.Index) || share.Proof.End != int32(p.Index)+1 {
+			return fmt.Errorf("proof of share %d must be for position %d", i, p.Index)
+		}
+		row, col := cellPosition(p.Axis, p.Index, uint(i))
+		namespace := appns.ParitySharesNamespace.Bytes()
+		if row < odsWidth && col < odsWidth {
+			namespace = share.Share[:appconsts.NamespaceSize]
+		}
+		nmtProof := nmt.NewInclusionProof(int(share.Proof.Start), int(share.Proof.End), share.Proof.Nodes, true)
+		if !nmtProof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, [][]byte{share.Share}, orthogonalRoots[i]) {
+			return fmt.Errorf("proof of share %d failed to verify", i)
+		}
+		// copy the share as the codec decodes in place
+		shares[i] = append([]byte(nil), share.Share...)
+		included++
+	}
+	if included < odsWidth {
+		return fmt.Errorf("the proof has %d shares, at least %d are needed to decode the %s", included, odsWidth, p.Axis)
+	}
+
+	// decode the axis and compare its root to the one of the header. A proof
+	// from which the axis can't be rebuilt doesn't show anything, so it is
+	// rejected.
+	rebuilt, err := appconsts.DefaultCodec().Decode(shares)
+	if err != nil {
+		return fmt.Errorf("decoding %s %d: %w", p.Axis, p.Index, err)
+	}
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(odsWidth), p.Index)
+	for i, share := range rebuilt {
+		if err := tree.Push(share); err != nil {
+			return fmt.Errorf("pushing share %d of %s %d: %w", i, p.Axis, p.Index, err)
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return fmt.Errorf("computing the root of %s %d: %w", p.Axis, p.Index, err)
+	}
+	if bytes.Equal(root, axisRoots[p.Index]) {
+		return fmt.Errorf("%s %d is correctly encoded", p.Axis, p.Index)
+	}
+	return nil
+}
+
+// cellPosition returns the row and column of the share at the provided
+// position of an axis.
+func cellPosition(axis rsmt2d.Axis, axisIndex, position uint) (row, col uint) {
+	if axis == rsmt2d.Row {
+		return axisIndex, position
+	}
+	return position, axisIndex
+}
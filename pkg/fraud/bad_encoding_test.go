@@ -0,0 +1,200 @@
+package fraud_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/fraud"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/malicious"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadEncodingProof(t *testing.T) {
+	eds := honestSquare(t)
+	odsWidth := eds.Width() / 2
+
+	type test struct {
+		name     string
+		row, col uint
+	}
+	tests := []test{
+		{name: "parity share of a row", row: 1, col: odsWidth + 1},
+		{name: "parity share of a column", row: odsWidth + 2, col: 0},
+		{name: "parity share of Q3", row: odsWidth, col: odsWidth},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			badEDS, dah := corruptShare(t, eds, tt.row, tt.col)
+
+			byzErr, err := fraud.FindByzantineData(badEDS, dah)
+			require.NoError(t, err)
+			require.NotNil(t, byzErr)
+
+			befp, err := fraud.NewBadEncodingProof(badEDS, byzErr)
+			require.NoError(t, err)
+			assert.NoError(t, befp.Validate(dah))
+
+			// the proof only contains the shares needed to decode the axis
+			included := 0
+			for _, share := range befp.Shares {
+				if share != nil {
+					included++
+				}
+			}
+			assert.Equal(t, int(odsWidth), included)
+		})
+	}
+}
+
+func TestBadEncodingProofRejectsInvalidProofs(t *testing.T) {
+	eds := honestSquare(t)
+	honestDAH, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	// an honest square has no byzantine data
+	byzErr, err := fraud.FindByzantineData(eds, honestDAH)
+	require.NoError(t, err)
+	require.Nil(t, byzErr)
+
+	// a proof for a correctly encoded axis is rejected
+	befp, err := fraud.NewBadEncodingProof(eds, &rsmt2d.ErrByzantineData{Axis: rsmt2d.Row, Index: 0, Shares: eds.Row(0)})
+	require.NoError(t, err)
+	assert.Error(t, befp.Validate(honestDAH))
+
+	badEDS, dah := corruptShare(t, eds, 0, eds.Width()-1)
+	validProof := func() *fraud.BadEncodingProof {
+		byzErr, err := fraud.FindByzantineData(badEDS, dah)
+		require.NoError(t, err)
+		require.NotNil(t, byzErr)
+		befp, err := fraud.NewBadEncodingProof(badEDS, byzErr)
+		require.NoError(t, err)
+		return befp
+	}
+	require.NoError(t, validProof().Validate(dah))
+
+	type test struct {
+		name   string
+		modify func(*fraud.BadEncodingProof)
+	}
+	tests := []test{
+		{
+			name: "not enough shares",
+			modify: func(p *fraud.BadEncodingProof) {
+				for i, share := range p.Shares {
+					if share != nil {
+						p.Shares[i] = nil
+						return
+					}
+				}
+			},
+		},
+		{
+			name: "share not included in the square",
+			modify: func(p *fraud.BadEncodingProof) {
+				for _, share := range p.Shares {
+					if share != nil {
+						share.Share = bytes.Repeat([]byte{1}, appconsts.ShareSize)
+						return
+					}
+				}
+			},
+		},
+		{
+			name: "proof for another position",
+			modify: func(p *fraud.BadEncodingProof) {
+				for _, share := range p.Shares {
+					if share != nil {
+						share.Proof.Start++
+						share.Proof.End++
+						return
+					}
+				}
+			},
+		},
+		{
+			name:   "index outside of the square",
+			modify: func(p *fraud.BadEncodingProof) { p.Index = uint(len(dah.RowRoots)) },
+		},
+		{
+			name:   "invalid axis",
+			modify: func(p *fraud.BadEncodingProof) { p.Axis = 2 },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			befp := validProof()
+			tt.modify(befp)
+			assert.Error(t, befp.Validate(dah))
+		})
+	}
+
+	// the proof doesn't hold against the honest header
+	assert.Error(t, validProof().Validate(honestDAH))
+}
+
+// TestBadEncodingProofOutOfOrderSquare checks that the out of order squares
+// produced by the malicious app are detected as byzantine data, and that their
+// proofs are rejected as the byzantine axis can't be rebuilt into an NMT.
+func TestBadEncodingProofOutOfOrderSquare(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1, ns2}, []int{100, 100})
+	txs := make([][]byte, len(blobTxs))
+	for i, tx := range blobTxs {
+		txs[i] = tx
+	}
+
+	dataSquare, err := malicious.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound, malicious.OutOfOrderExport)
+	require.NoError(t, err)
+	eds, err := malicious.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	byzErr, err := fraud.FindByzantineData(eds, dah)
+	require.NoError(t, err)
+	require.NotNil(t, byzErr)
+
+	befp, err := fraud.NewBadEncodingProof(eds, byzErr)
+	require.NoError(t, err)
+	assert.ErrorContains(t, befp.Validate(dah), "lexicographically ordered")
+}
+
+func honestSquare(t *testing.T) *rsmt2d.ExtendedDataSquare {
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	return eds
+}
+
+// corruptShare returns a copy of the square in which the share at the
+// provided position is corrupted, along with the data availability header of
+// the corrupted square.
+func corruptShare(t *testing.T, eds *rsmt2d.ExtendedDataSquare, row, col uint) (*rsmt2d.ExtendedDataSquare, da.DataAvailabilityHeader) {
+	flattened := eds.Flattened()
+	i := row*eds.Width() + col
+	corrupted := append([]byte(nil), flattened[i]...)
+	corrupted[len(corrupted)-1] ^= 0xFF
+	flattened[i] = corrupted
+
+	badEDS, err := rsmt2d.ImportExtendedDataSquare(flattened, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(eds.Width()/2)))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(badEDS)
+	require.NoError(t, err)
+	return badEDS, dah
+}
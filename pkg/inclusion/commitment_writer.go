@@ -0,0 +1,192 @@
+package inclusion
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/go-square/inclusion"
+	"github.com/celestiaorg/go-square/merkle"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/nmt"
+)
+
+// CommitmentWriter computes the share commitment of a blob from its data as it
+// is written. The data is split into shares on the fly and only the shares of
+// the subtree being computed are kept in memory, so the data of large blobs
+// doesn't need to be buffered to be committed to. The commitment is identical
+// to the one of inclusion.CreateCommitment.
+type CommitmentWriter struct {
+	namespace    appns.Namespace
+	shareVersion uint8
+	dataLen      int
+	written      int
+	// treeSizes are the numbers of shares of the subtrees whose roots are
+	// committed to. They are sized using the subtree root threshold.
+	treeSizes    []uint64
+	subTreeRoots [][]byte
+	// tree is the subtree to which the shares are currently pushed, and pushed
+	// the number of shares pushed to it.
+	tree   *nmt.NamespacedMerkleTree
+	pushed uint64
+	// leaves is the buffer from which the leaves of the current subtree are
+	// carved. It is reused by the next subtree once the root is computed.
+	leaves []byte
+	// share is the share being filled with data.
+	share []byte
+}
+
+// NewCommitmentWriter returns a CommitmentWriter for a blob of dataLen bytes.
+func NewCommitmentWriter(namespace appns.Namespace, shareVersion uint8, dataLen, subtreeRootThreshold int) (*CommitmentWriter, error) {
+	if len(namespace.ID) != appns.NamespaceIDSize {
+		return nil, fmt.Errorf("namespace id must be %d bytes", appns.NamespaceIDSize)
+	}
+	if namespace.Version > appns.NamespaceVersionMax {
+		return nil, errors.New("namespace version can not be greater than MaxNamespaceVersion")
+	}
+	if !slices.Contains(shares.SupportedShareVersions, shareVersion) {
+		return nil, fmt.Errorf("unsupported share version: %d", shareVersion)
+	}
+	if dataLen <= 0 || uint64(dataLen) > math.MaxUint32 {
+		return nil, fmt.Errorf("invalid blob length %d", dataLen)
+	}
+	if subtreeRootThreshold <= 0 {
+		return nil, fmt.Errorf("subtree root threshold must be positive, got %d", subtreeRootThreshold)
+	}
+
+	shareCount := shares.SparseSharesNeeded(uint32(dataLen))
+	subTreeWidth := inclusion.SubTreeWidth(shareCount, subtreeRootThreshold)
+	treeSizes, err := inclusion.MerkleMountainRangeSizes(uint64(shareCount), uint64(subTreeWidth))
+	if err != nil {
+		return nil, err
+	}
+	w := &CommitmentWriter{
+		namespace:    namespace,
+		shareVersion: shareVersion,
+		dataLen:      dataLen,
+		treeSizes:    treeSizes,
+		subTreeRoots: make([][]byte, 0, len(treeSizes)),
+		share:        make([]byte, 0, appconsts.ShareSize),
+	}
+	if err := w.startShare(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write splits p into the shares of the blob. It returns an error if more data
+// than the length of the blob is written.
+func (w *CommitmentWriter) Write(p []byte) (int, error) {
+	if len(p) > w.dataLen-w.written {
+		return 0, fmt.Errorf("writing %d bytes exceeds the blob length of %d bytes by %d", len(p), w.dataLen, len(p)-(w.dataLen-w.written))
+	}
+	n := len(p)
+	for len(p) > 0 {
+		chunk := min(appconsts.ShareSize-len(w.share), len(p))
+		w.share = append(w.share, p[:chunk]...)
+		w.written += chunk
+		p = p[chunk:]
+
+		if len(w.share) < appconsts.ShareSize && w.written < w.dataLen {
+			continue
+		}
+		// zero pad the last share of the blob
+		w.share = append(w.share, make([]byte, appconsts.ShareSize-len(w.share))...)
+		if err := w.pushShare(); err != nil {
+			return 0, err
+		}
+		if w.written < w.dataLen {
+			if err := w.startShare(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Commitment returns the share commitment of the blob. All the data of the blob
+// must have been written.
+func (w *CommitmentWriter) Commitment() ([]byte, error) {
+	if w.written != w.dataLen {
+		return nil, fmt.Errorf("only %d of the %d bytes of the blob were written", w.written, w.dataLen)
+	}
+	return merkle.HashFromByteSlices(w.subTreeRoots), nil
+}
+
+// startShare writes the namespace and the info byte of the next share, and
+// the sequence length if it is the first share of the blob.
+func (w *CommitmentWriter) startShare() error {
+	isFirstShare := w.written == 0
+	infoByte, err := shares.NewInfoByte(w.shareVersion, isFirstShare)
+	if err != nil {
+		return err
+	}
+	w.share = append(w.share[:0], w.namespace.Bytes()...)
+	w.share = append(w.share, byte(infoByte))
+	if isFirstShare {
+		w.share = binary.BigEndian.AppendUint32(w.share, uint32(w.dataLen))
+	}
+	return nil
+}
+
+// pushShare pushes the current share to the current subtree, and computes the
+// root of the subtree once it is complete.
+func (w *CommitmentWriter) pushShare() error {
+	treeSize := w.treeSizes[len(w.subTreeRoots)]
+	leafSize := appconsts.NamespaceSize + appconsts.ShareSize
+	if w.tree == nil {
+		w.tree = nmt.New(appconsts.NewBaseHashFunc(),
+			nmt.NamespaceIDSize(appconsts.NamespaceSize),
+			nmt.IgnoreMaxNamespace(true),
+			nmt.InitialCapacity(int(treeSize)),
+		)
+		if cap(w.leaves) < int(treeSize)*leafSize {
+			w.leaves = make([]byte, 0, int(treeSize)*leafSize)
+		}
+		w.leaves = w.leaves[:0]
+	}
+
+	// the namespace is prepended to the share to match the leaves of the NMT
+	// wrapper (pkg/wrapper)
+	start := len(w.leaves)
+	w.leaves = append(w.leaves, w.namespace.Bytes()...)
+	w.leaves = append(w.leaves, w.share...)
+	if err := w.tree.Push(w.leaves[start:len(w.leaves):len(w.leaves)]); err != nil {
+		return err
+	}
+	w.pushed++
+	if w.pushed < treeSize {
+		return nil
+	}
+
+	root, err := w.tree.Root()
+	if err != nil {
+		return err
+	}
+	w.subTreeRoots = append(w.subTreeRoots, root)
+	w.tree = nil
+	w.pushed = 0
+	return nil
+}
+
+// CreateCommitmentFromReader computes the share commitment of a blob of
+// dataLen bytes read from r, without holding the data of the blob in memory.
+// The commitment is identical to the one of inclusion.CreateCommitment.
+func CreateCommitmentFromReader(r io.Reader, namespace appns.Namespace, shareVersion uint8, dataLen, subtreeRootThreshold int) ([]byte, error) {
+	w, err := NewCommitmentWriter(namespace, shareVersion, dataLen, subtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.CopyN(w, r, int64(dataLen)); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("reading %d bytes of blob data: %w", dataLen, io.ErrUnexpectedEOF)
+		}
+		return nil, err
+	}
+	return w.Commitment()
+}
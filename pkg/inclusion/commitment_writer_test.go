@@ -0,0 +1,136 @@
+package inclusion
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/go-square/blob"
+	"github.com/celestiaorg/go-square/inclusion"
+	"github.com/celestiaorg/go-square/merkle"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+// TestCreateCommitmentFromReader checks that the streamed commitment matches
+// the commitment of the full blob for random blob sizes, subtree root
+// thresholds and write sizes.
+func TestCreateCommitmentFromReader(t *testing.T) {
+	rand := tmrand.NewRand()
+	rand.Seed(1)
+
+	// sizes around the share boundaries, where the padding and the number of
+	// shares change
+	sizes := []int{
+		1,
+		shares.FirstSparseShareContentSize - 1,
+		shares.FirstSparseShareContentSize,
+		shares.FirstSparseShareContentSize + 1,
+		shares.FirstSparseShareContentSize + shares.ContinuationSparseShareContentSize,
+		shares.FirstSparseShareContentSize + 100*shares.ContinuationSparseShareContentSize + 1,
+	}
+	for i := 0; i < 100; i++ {
+		sizes = append(sizes, rand.Intn(200*appconsts.ShareSize)+1)
+	}
+	thresholds := []int{1, 2, 7, appconsts.DefaultSubtreeRootThreshold, 128}
+
+	for _, size := range sizes {
+		namespace := testfactory.RandomBlobNamespaceWithPRG(rand)
+		data := rand.Bytes(size)
+		threshold := thresholds[rand.Intn(len(thresholds))]
+		want, err := inclusion.CreateCommitment(blob.New(namespace, data, appconsts.ShareVersionZero), merkle.HashFromByteSlices, threshold)
+		require.NoError(t, err)
+
+		readers := map[string]io.Reader{
+			"full":      bytes.NewReader(data),
+			"one byte":  iotest.OneByteReader(bytes.NewReader(data)),
+			"half":      iotest.HalfReader(bytes.NewReader(data)),
+			"data err":  iotest.DataErrReader(bytes.NewReader(data)),
+			"chunks 37": &chunkReader{data: data, size: 37},
+		}
+		for name, r := range readers {
+			got, err := CreateCommitmentFromReader(r, namespace, appconsts.ShareVersionZero, size, threshold)
+			require.NoError(t, err, name)
+			assert.Equal(t, want, got, "size %d threshold %d reader %s", size, threshold, name)
+		}
+	}
+}
+
+func TestCreateCommitmentFromReaderLargeBlob(t *testing.T) {
+	namespace := testfactory.RandomBlobNamespace()
+	data := tmrand.Bytes(4 * 1024 * 1024)
+	want, err := inclusion.CreateCommitment(blob.New(namespace, data, appconsts.ShareVersionZero), merkle.HashFromByteSlices, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	got, err := CreateCommitmentFromReader(bytes.NewReader(data), namespace, appconsts.ShareVersionZero, len(data), appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestCommitmentWriterErrors(t *testing.T) {
+	namespace := testfactory.RandomBlobNamespace()
+
+	_, err := NewCommitmentWriter(namespace, appconsts.ShareVersionZero, 0, appconsts.DefaultSubtreeRootThreshold)
+	assert.Error(t, err)
+	_, err = NewCommitmentWriter(namespace, 1, 10, appconsts.DefaultSubtreeRootThreshold)
+	assert.Error(t, err)
+	_, err = NewCommitmentWriter(namespace, appconsts.ShareVersionZero, 10, 0)
+	assert.Error(t, err)
+	_, err = NewCommitmentWriter(appns.Namespace{Version: appns.NamespaceVersionZero, ID: []byte{1}}, appconsts.ShareVersionZero, 10, appconsts.DefaultSubtreeRootThreshold)
+	assert.Error(t, err)
+
+	// writing more than the length of the blob
+	w, err := NewCommitmentWriter(namespace, appconsts.ShareVersionZero, 10, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	_, err = w.Write(make([]byte, 11))
+	assert.Error(t, err)
+
+	// computing the commitment before all the data is written
+	_, err = w.Write(make([]byte, 9))
+	require.NoError(t, err)
+	_, err = w.Commitment()
+	assert.Error(t, err)
+
+	// reading less than the length of the blob
+	_, err = CreateCommitmentFromReader(bytes.NewReader(make([]byte, 9)), namespace, appconsts.ShareVersionZero, 10, appconsts.DefaultSubtreeRootThreshold)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func BenchmarkCreateCommitment(b *testing.B) {
+	namespace := testfactory.RandomBlobNamespace()
+	data := tmrand.Bytes(8 * 1024 * 1024)
+	b.Run("go-square", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := inclusion.CreateCommitment(blob.New(namespace, data, appconsts.ShareVersionZero), merkle.HashFromByteSlices, appconsts.DefaultSubtreeRootThreshold)
+			require.NoError(b, err)
+		}
+	})
+	b.Run("reader", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := CreateCommitmentFromReader(bytes.NewReader(data), namespace, appconsts.ShareVersionZero, len(data), appconsts.DefaultSubtreeRootThreshold)
+			require.NoError(b, err)
+		}
+	})
+}
+
+// chunkReader reads its data in chunks of a fixed size.
+type chunkReader struct {
+	data []byte
+	size int
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p[:min(len(p), r.size)], r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
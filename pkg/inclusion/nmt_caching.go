@@ -24,6 +24,10 @@ const (
 // inner nodes.
 type subTreeRootCacher struct {
 	cache map[string][2]string
+	// root is the root of the tree, set once it is computed.
+	root []byte
+	// size is the approximate number of bytes used by the cached nodes.
+	size int
 }
 
 func newSubTreeRootCacher() *subTreeRootCacher {
@@ -37,6 +41,7 @@ func (strc *subTreeRootCacher) Visit(hash []byte, children ...[]byte) {
 	switch len(children) {
 	case 2:
 		strc.cache[string(hash)] = [2]string{string(children[0]), string(children[1])}
+		strc.size += len(hash) + len(children[0]) + len(children[1])
 	case 1:
 		return
 	default:
@@ -73,52 +78,240 @@ func (strc subTreeRootCacher) walk(root []byte, path []WalkInstruction) ([]byte,
 	}
 }
 
+// prove appends to nodes the hashes of the subtrees of node that are outside
+// of the leaf range [start, end), from left to right, as in an NMT range proof.
+// node is the root of the subtree of the leaves [lo, hi).
+func (strc subTreeRootCacher) prove(node []byte, lo, hi, start, end int, nodes [][]byte) ([][]byte, error) {
+	if end <= lo || hi <= start {
+		return append(nodes, node), nil
+	}
+	if start <= lo && hi <= end {
+		return nodes, nil
+	}
+	children, has := strc.cache[string(node)]
+	if !has {
+		return nil, fmt.Errorf("did not find sub tree root: %v", node)
+	}
+	mid := lo + (hi-lo)/2
+	nodes, err := strc.prove([]byte(children[0]), lo, mid, start, end, nodes)
+	if err != nil {
+		return nil, err
+	}
+	return strc.prove([]byte(children[1]), mid, hi, start, end, nodes)
+}
+
 // EDSSubTreeRootCacher caches the inner nodes for each row so that we can
-// traverse it later to check for blob inclusion. NOTE: Currently this is not
-// threadsafe, but with a future refactor, we could simply read from rsmt2d and
-// not use the tree constructor which would fix both of these issues.
+// traverse it later to check for blob inclusion or to generate NMT range proofs
+// without rebuilding the row trees. The rows are cached when the cacher is used
+// as the tree constructor of an extended data square and its roots are
+// computed. If a memory bound is set, the least recently used rows are evicted
+// once the cached rows exceed it.
 type EDSSubTreeRootCacher struct {
 	mut        *sync.RWMutex
 	caches     map[uint]*subTreeRootCacher
 	squareSize uint64
+	// maxSize is the maximum approximate number of bytes of the cached rows,
+	// or zero if the cache is unbounded.
+	maxSize int
+	// size is the approximate number of bytes of the rows whose root was
+	// computed.
+	size int
+	// recent are the rows whose root was computed, from the least to the most
+	// recently used.
+	recent []uint
 }
 
 func NewSubtreeCacher(squareSize uint64) *EDSSubTreeRootCacher {
+	return NewBoundedSubtreeCacher(squareSize, 0)
+}
+
+// NewBoundedSubtreeCacher returns a cacher that evicts the least recently used
+// rows once the cached rows use more than approximately maxSize bytes. The
+// row that was computed last is never evicted. A maxSize of zero doesn't bound
+// the cache.
+func NewBoundedSubtreeCacher(squareSize uint64, maxSize int) *EDSSubTreeRootCacher {
 	return &EDSSubTreeRootCacher{
 		mut:        &sync.RWMutex{},
 		caches:     make(map[uint]*subTreeRootCacher),
 		squareSize: squareSize,
+		maxSize:    maxSize,
 	}
 }
 
 // Constructor fulfills the rsmt2d.TreeCreatorFn by keeping a pointer to the
 // cache and embedding it as a nmt.NodeVisitor into a new wrapped nmt.
 func (stc *EDSSubTreeRootCacher) Constructor(axis rsmt2d.Axis, axisIndex uint) rsmt2d.Tree {
-	var newTree wrapper.ErasuredNamespacedMerkleTree
 	switch axis {
 	case rsmt2d.Row:
 		strc := newSubTreeRootCacher()
 		stc.mut.Lock()
+		stc.remove(axisIndex)
 		stc.caches[axisIndex] = strc
 		stc.mut.Unlock()
-		newTree = wrapper.NewErasuredNamespacedMerkleTree(stc.squareSize, axisIndex, nmt.NodeVisitor(strc.Visit))
+		newTree := wrapper.NewErasuredNamespacedMerkleTree(stc.squareSize, axisIndex, nmt.NodeVisitor(strc.Visit))
+		return &cachedTree{ErasuredNamespacedMerkleTree: &newTree, stc: stc, row: axisIndex, strc: strc}
 	default:
-		newTree = wrapper.NewErasuredNamespacedMerkleTree(stc.squareSize, axisIndex)
+		newTree := wrapper.NewErasuredNamespacedMerkleTree(stc.squareSize, axisIndex)
+		return &newTree
 	}
-	return &newTree
 }
 
 // getSubTreeRoot traverses the nmt of the selected row and returns the
 // subtree root. An error is thrown if the subtree cannot be found.
 func (stc *EDSSubTreeRootCacher) getSubTreeRoot(dah da.DataAvailabilityHeader, row int, path []WalkInstruction) ([]byte, error) {
-	if len(stc.caches) != len(dah.RowRoots) {
-		return nil, fmt.Errorf("data availability header has unexpected number of row roots: expected %d got %d", len(stc.caches), len(dah.RowRoots))
+	if row < 0 || row >= len(dah.RowRoots) {
+		return nil, fmt.Errorf("row exceeds range of data availability header: max %d got %d", len(dah.RowRoots), row)
 	}
-	if row >= len(stc.caches) {
-		return nil, fmt.Errorf("row exceeds range of cache: max %d got %d", len(stc.caches), row)
+	strc, err := stc.row(uint(row))
+	if err != nil {
+		return nil, err
 	}
 	stc.mut.RLock()
-	sbt, err := stc.caches[uint(row)].walk(dah.RowRoots[row], path)
+	sbt, err := strc.walk(dah.RowRoots[row], path)
 	stc.mut.RUnlock()
 	return sbt, err
 }
+
+// RowRoot returns the root of the cached row.
+func (stc *EDSSubTreeRootCacher) RowRoot(row uint) ([]byte, error) {
+	strc, err := stc.row(row)
+	if err != nil {
+		return nil, err
+	}
+	return strc.root, nil
+}
+
+// ProveRange returns an NMT range proof for the leaves [start, end) of the row,
+// where end is non-inclusive. The proof is generated from the cached inner
+// nodes and is identical to the proof of the row tree.
+func (stc *EDSSubTreeRootCacher) ProveRange(row uint, start, end int) (nmt.Proof, error) {
+	width := int(2 * stc.squareSize)
+	if start < 0 || start >= end || end > width {
+		return nmt.Proof{}, fmt.Errorf("invalid range [%d, %d) for a row of %d leaves", start, end, width)
+	}
+	strc, err := stc.row(row)
+	if err != nil {
+		return nmt.Proof{}, err
+	}
+	stc.mut.RLock()
+	nodes, err := strc.prove(strc.root, 0, width, start, end, [][]byte{})
+	stc.mut.RUnlock()
+	if err != nil {
+		return nmt.Proof{}, err
+	}
+	return nmt.NewInclusionProof(start, end, nodes, true), nil
+}
+
+// ProveSubTree returns an NMT range proof for the leaves of the subtree of the
+// row found by following path from the root of the row.
+func (stc *EDSSubTreeRootCacher) ProveSubTree(row uint, path []WalkInstruction) (nmt.Proof, error) {
+	start, size := 0, int(2*stc.squareSize)
+	for _, instruction := range path {
+		if size == 1 {
+			return nmt.Proof{}, fmt.Errorf("path of length %d is deeper than the row tree", len(path))
+		}
+		size /= 2
+		if instruction == WalkRight {
+			start += size
+		}
+	}
+	return stc.ProveRange(row, start, start+size)
+}
+
+// Evict removes the rows from the cache.
+func (stc *EDSSubTreeRootCacher) Evict(rows ...uint) {
+	stc.mut.Lock()
+	defer stc.mut.Unlock()
+	for _, row := range rows {
+		stc.remove(row)
+	}
+}
+
+// Size returns the approximate number of bytes used by the cached rows whose
+// root was computed.
+func (stc *EDSSubTreeRootCacher) Size() int {
+	stc.mut.RLock()
+	defer stc.mut.RUnlock()
+	return stc.size
+}
+
+// row returns the cache of a row whose root was computed, and marks the row as
+// the most recently used.
+func (stc *EDSSubTreeRootCacher) row(row uint) (*subTreeRootCacher, error) {
+	stc.mut.Lock()
+	defer stc.mut.Unlock()
+	strc, has := stc.caches[row]
+	if !has || strc.root == nil {
+		return nil, fmt.Errorf("row %d is not cached", row)
+	}
+	stc.touch(row)
+	return strc, nil
+}
+
+// complete records the root of the row once computed, then evicts the least
+// recently used rows if the cache exceeds its memory bound.
+func (stc *EDSSubTreeRootCacher) complete(row uint, strc *subTreeRootCacher, root []byte) {
+	stc.mut.Lock()
+	defer stc.mut.Unlock()
+	// the row was evicted or rebuilt while computing its root
+	if stc.caches[row] != strc {
+		return
+	}
+	if strc.root == nil {
+		stc.size += strc.size
+	}
+	strc.root = root
+	stc.touch(row)
+	for stc.maxSize > 0 && stc.size > stc.maxSize && len(stc.recent) > 1 {
+		stc.remove(stc.recent[0])
+	}
+}
+
+// touch moves the row to the end of the recently used rows. It must be called
+// with the lock held.
+func (stc *EDSSubTreeRootCacher) touch(row uint) {
+	stc.forget(row)
+	stc.recent = append(stc.recent, row)
+}
+
+// forget removes the row from the recently used rows. It must be called with
+// the lock held.
+func (stc *EDSSubTreeRootCacher) forget(row uint) {
+	for i, r := range stc.recent {
+		if r == row {
+			stc.recent = append(stc.recent[:i], stc.recent[i+1:]...)
+			return
+		}
+	}
+}
+
+// remove removes the row from the cache. It must be called with the lock held.
+func (stc *EDSSubTreeRootCacher) remove(row uint) {
+	strc, has := stc.caches[row]
+	if !has {
+		return
+	}
+	if strc.root != nil {
+		stc.size -= strc.size
+		stc.forget(row)
+	}
+	delete(stc.caches, row)
+}
+
+// cachedTree records the root of a row tree in the cache once it is computed.
+type cachedTree struct {
+	*wrapper.ErasuredNamespacedMerkleTree
+	stc  *EDSSubTreeRootCacher
+	row  uint
+	strc *subTreeRootCacher
+}
+
+// Root fulfills the rsmt2d.Tree interface.
+func (t *cachedTree) Root() ([]byte, error) {
+	root, err := t.ErasuredNamespacedMerkleTree.Root()
+	if err != nil {
+		return nil, err
+	}
+	t.stc.complete(t.row, t.strc, root)
+	return root, nil
+}
@@ -203,3 +203,87 @@ func generateRandNamespacedRawData(count int) (result [][]byte) {
 func sortByteArrays(src [][]byte) {
 	sort.Slice(src, func(i, j int) bool { return bytes.Compare(src[i], src[j]) < 0 })
 }
+
+func TestEDSSubTreeRootCacherProveRange(t *testing.T) {
+	squareSize := 4
+	width := 2 * squareSize
+	d := generateRandNamespacedRawData(squareSize * squareSize)
+	stc := NewSubtreeCacher(uint64(squareSize))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(d, appconsts.DefaultCodec(), stc.Constructor)
+	require.NoError(t, err)
+	rowRoots, err := eds.RowRoots()
+	require.NoError(t, err)
+
+	for row := 0; row < width; row++ {
+		tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(row))
+		for _, share := range eds.Row(uint(row)) {
+			require.NoError(t, tree.Push(share))
+		}
+		root, err := stc.RowRoot(uint(row))
+		require.NoError(t, err)
+		assert.Equal(t, rowRoots[row], root)
+
+		for start := 0; start < width; start++ {
+			for end := start + 1; end <= width; end++ {
+				want, err := tree.ProveRange(start, end)
+				require.NoError(t, err)
+				got, err := stc.ProveRange(uint(row), start, end)
+				require.NoError(t, err)
+				assert.Equal(t, want, got, "row %d range [%d, %d)", row, start, end)
+			}
+		}
+	}
+
+	// the proof of a subtree is the proof of its leaf range
+	got, err := stc.ProveSubTree(1, []WalkInstruction{WalkRight, WalkLeft})
+	require.NoError(t, err)
+	want, err := stc.ProveRange(1, 4, 6)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	_, err = stc.ProveSubTree(1, []WalkInstruction{WalkRight, WalkRight, WalkRight, WalkRight})
+	assert.Error(t, err)
+
+	_, err = stc.ProveRange(0, 2, 2)
+	assert.Error(t, err)
+	_, err = stc.ProveRange(0, 0, width+1)
+	assert.Error(t, err)
+}
+
+func TestEDSSubTreeRootCacherEviction(t *testing.T) {
+	squareSize := 8
+	d := generateRandNamespacedRawData(squareSize * squareSize)
+
+	unbounded := NewSubtreeCacher(uint64(squareSize))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(d, appconsts.DefaultCodec(), unbounded.Constructor)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	rowSize := unbounded.Size() / len(dah.RowRoots)
+	require.Positive(t, rowSize)
+
+	// evicted rows can't be used anymore
+	unbounded.Evict(0, 1)
+	assert.Equal(t, rowSize*(len(dah.RowRoots)-2), unbounded.Size())
+	_, err = unbounded.getSubTreeRoot(dah, 0, []WalkInstruction{WalkLeft})
+	assert.Error(t, err)
+	_, err = unbounded.ProveRange(1, 0, 1)
+	assert.Error(t, err)
+	_, err = unbounded.getSubTreeRoot(dah, 2, []WalkInstruction{WalkLeft})
+	assert.NoError(t, err)
+
+	// a bounded cacher keeps the most recently computed rows
+	bounded := NewBoundedSubtreeCacher(uint64(squareSize), 3*rowSize)
+	eds, err = rsmt2d.ComputeExtendedDataSquare(d, appconsts.DefaultCodec(), bounded.Constructor)
+	require.NoError(t, err)
+	_, err = eds.RowRoots()
+	require.NoError(t, err)
+	assert.LessOrEqual(t, bounded.Size(), 3*rowSize)
+
+	cached := 0
+	for row := range dah.RowRoots {
+		if _, err := bounded.RowRoot(uint(row)); err == nil {
+			cached++
+		}
+	}
+	assert.Equal(t, 3, cached)
+}
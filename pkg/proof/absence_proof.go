@@ -0,0 +1,269 @@
+package proof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	"github.com/celestiaorg/go-square/merkle"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// NewNamespaceAbsenceProof takes an ODS, extends it, then returns a proof that
+// the namespace has no shares in the ODS.
+func NewNamespaceAbsenceProof(dataSquare square.Square, namespace appns.Namespace) (NamespaceAbsenceProof, error) {
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return NamespaceAbsenceProof{}, err
+	}
+	return NewNamespaceAbsenceProofFromEDS(eds, namespace)
+}
+
+// NewNamespaceAbsenceProofFromEDS takes an extended data square and returns a
+// proof that the namespace has no shares in its ODS. The proof covers the rows
+// whose namespace ranges contain the namespace, or, if there are none, the
+// rows surrounding the namespace. It returns an error if the namespace is
+// present in the square.
+func NewNamespaceAbsenceProofFromEDS(
+	eds *rsmt2d.ExtendedDataSquare,
+	namespace appns.Namespace,
+) (NamespaceAbsenceProof, error) {
+	squareSize := int(eds.Width() / 2)
+
+	edsRowRoots, err := eds.RowRoots()
+	if err != nil {
+		return NamespaceAbsenceProof{}, err
+	}
+
+	edsColRoots, err := eds.ColRoots()
+	if err != nil {
+		return NamespaceAbsenceProof{}, err
+	}
+
+	startRow, endRow := absenceRowRange(edsRowRoots[:squareSize], namespace.Bytes())
+
+	// create the binary merkle inclusion proof for the rows to the data root
+	_, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, edsColRoots...))
+	rowProofs := make([]*Proof, endRow-startRow+1)
+	rowRoots := make([][]byte, endRow-startRow+1)
+	absenceProofs := make([]*NMTProof, endRow-startRow+1)
+	for i := startRow; i <= endRow; i++ {
+		rowProofs[i-startRow] = &Proof{
+			Total:    allProofs[i].Total,
+			Index:    allProofs[i].Index,
+			LeafHash: allProofs[i].LeafHash,
+			Aunts:    allProofs[i].Aunts,
+		}
+		rowRoots[i-startRow] = edsRowRoots[i]
+
+		// we have to re-create the tree as the eds one is not accessible.
+		tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(i))
+		for _, share := range eds.Row(uint(i)) {
+			if err := tree.Push(share); err != nil {
+				return NamespaceAbsenceProof{}, err
+			}
+		}
+
+		// make sure that the generated root is the same as the eds row root.
+		root, err := tree.Root()
+		if err != nil {
+			return NamespaceAbsenceProof{}, err
+		}
+		if !bytes.Equal(edsRowRoots[i], root) {
+			return NamespaceAbsenceProof{}, errors.New("eds row root is different than tree root")
+		}
+
+		proof, err := tree.ProveNamespace(namespace.Bytes())
+		if err != nil {
+			return NamespaceAbsenceProof{}, err
+		}
+		if proof.Start() != proof.End() && !proof.IsOfAbsence() {
+			return NamespaceAbsenceProof{}, fmt.Errorf("namespace %X is present in row %d", namespace.Bytes(), i)
+		}
+		absenceProofs[i-startRow] = &NMTProof{
+			Start:    int32(proof.Start()),
+			End:      int32(proof.End()),
+			Nodes:    proof.Nodes(),
+			LeafHash: proof.LeafHash(),
+		}
+	}
+
+	return NamespaceAbsenceProof{
+		NamespaceId:      namespace.ID,
+		NamespaceVersion: uint32(namespace.Version),
+		RowProof: &RowProof{
+			RowRoots: rowRoots,
+			Proofs:   rowProofs,
+			StartRow: uint32(startRow),
+			EndRow:   uint32(endRow),
+		},
+		AbsenceProofs: absenceProofs,
+	}, nil
+}
+
+// absenceRowRange returns the inclusive range of rows that needs to be proven
+// to show the absence of the namespace. These are the rows whose namespace
+// ranges contain the namespace. If there are none, these are the row before
+// and the row after the namespace, so that the verifier can check that the
+// namespace falls between them.
+func absenceRowRange(rowRoots [][]byte, namespace []byte) (startRow, endRow int) {
+	// find the first row that ends at or after the namespace
+	first := len(rowRoots) - 1
+	for i, root := range rowRoots {
+		if bytes.Compare(maxNamespace(root), namespace) >= 0 {
+			first = i
+			break
+		}
+	}
+	if bytes.Compare(minNamespace(rowRoots[first]), namespace) > 0 || bytes.Compare(maxNamespace(rowRoots[first]), namespace) < 0 {
+		// no row contains the namespace in its range
+		if first > 0 && bytes.Compare(minNamespace(rowRoots[first]), namespace) > 0 {
+			return first - 1, first
+		}
+		return first, first
+	}
+	last := first
+	for last+1 < len(rowRoots) && bytes.Compare(minNamespace(rowRoots[last+1]), namespace) <= 0 {
+		last++
+	}
+	return first, last
+}
+
+// Validate runs basic validations on the proof then verifies it. It returns
+// nil if the proof is valid. Otherwise, it returns a sensible error. The
+// `root` is the block data root that the rows of the proof belong to.
+func (p NamespaceAbsenceProof) Validate(root []byte) error {
+	if p.RowProof == nil || len(p.RowProof.RowRoots) == 0 {
+		return errors.New("empty namespace absence proof")
+	}
+	if len(p.AbsenceProofs) != len(p.RowProof.RowRoots) {
+		return fmt.Errorf("the number of absence proofs %d must equal the number of row roots %d", len(p.AbsenceProofs), len(p.RowProof.RowRoots))
+	}
+	if p.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("invalid namespace version %d", p.NamespaceVersion)
+	}
+	namespace := p.namespace()
+	if len(namespace) != appconsts.NamespaceSize {
+		return fmt.Errorf("namespace must be %d bytes, got %d", appconsts.NamespaceSize, len(namespace))
+	}
+	if err := p.RowProof.Validate(root); err != nil {
+		return err
+	}
+
+	// the data root commits to the row roots followed by the column roots of
+	// the EDS, so the total number of leaves is four times the square size.
+	total := p.RowProof.Proofs[0].Total
+	if total%4 != 0 {
+		return fmt.Errorf("invalid number of data root leaves %d", total)
+	}
+	squareSize := total / 4
+	for i, proof := range p.RowProof.Proofs {
+		if proof.Total != total {
+			return fmt.Errorf("row proof %d has total %d, expected %d", i, proof.Total, total)
+		}
+		if proof.Index != int64(p.RowProof.StartRow)+int64(i) {
+			return fmt.Errorf("row proof %d has index %d, expected %d", i, proof.Index, int64(p.RowProof.StartRow)+int64(i))
+		}
+	}
+	if int64(p.RowProof.EndRow) >= squareSize {
+		return fmt.Errorf("end row %d is outside of the original data square of size %d", p.RowProof.EndRow, squareSize)
+	}
+
+	rowRoots := p.RowProof.RowRoots
+	for i, rowRoot := range rowRoots {
+		if len(rowRoot) != rowRootSize {
+			return fmt.Errorf("row root %d must be %d bytes, got %d", i, rowRootSize, len(rowRoot))
+		}
+	}
+
+	// the rows of the original data square are ordered by namespace. So, if
+	// the first row starts before the namespace and the last row ends after
+	// it, the rows outside the proof cannot contain the namespace.
+	if p.RowProof.StartRow > 0 && bytes.Compare(minNamespace(rowRoots[0]), namespace) >= 0 {
+		return fmt.Errorf("start row %d doesn't start before the namespace", p.RowProof.StartRow)
+	}
+	if int64(p.RowProof.EndRow) < squareSize-1 && bytes.Compare(maxNamespace(rowRoots[len(rowRoots)-1]), namespace) <= 0 {
+		return fmt.Errorf("end row %d doesn't end after the namespace", p.RowProof.EndRow)
+	}
+
+	if ok := p.VerifyProof(); !ok {
+		return errors.New("namespace absence proof failed to verify")
+	}
+	return nil
+}
+
+// VerifyProof verifies that the namespace is absent from all the rows of the
+// proof.
+func (p NamespaceAbsenceProof) VerifyProof() bool {
+	if p.NamespaceVersion > math.MaxUint8 {
+		return false
+	}
+	namespace := p.namespace()
+	for i, proof := range p.AbsenceProofs {
+		var nmtProof nmt.Proof
+		if len(proof.LeafHash) != 0 {
+			nmtProof = nmt.NewAbsenceProof(int(proof.Start), int(proof.End), proof.Nodes, proof.LeafHash, true)
+		} else {
+			nmtProof = nmt.NewInclusionProof(int(proof.Start), int(proof.End), proof.Nodes, true)
+		}
+		// verifying a proof against no leaves only succeeds if the proof is
+		// an absence proof, or an empty proof for a namespace outside of the
+		// namespace range of the row.
+		if !nmtProof.VerifyNamespace(appconsts.NewBaseHashFunc(), namespace, nil, p.RowProof.RowRoots[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyAgainstDAH verifies the proof against the data root of the data
+// availability header, and checks that it covers all the rows of the original
+// data square whose namespace ranges contain the namespace.
+func (p NamespaceAbsenceProof) VerifyAgainstDAH(dah da.DataAvailabilityHeader) error {
+	if err := dah.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := p.Validate(dah.Hash()); err != nil {
+		return err
+	}
+	namespace := p.namespace()
+	for i, root := range dah.RowRoots[:dah.SquareSize()] {
+		if i >= int(p.RowProof.StartRow) && i <= int(p.RowProof.EndRow) {
+			continue
+		}
+		if len(root) != rowRootSize {
+			return fmt.Errorf("row root %d must be %d bytes, got %d", i, rowRootSize, len(root))
+		}
+		if bytes.Compare(minNamespace(root), namespace) <= 0 && bytes.Compare(maxNamespace(root), namespace) >= 0 {
+			return fmt.Errorf("row %d may contain the namespace but is not covered by the proof", i)
+		}
+	}
+	return nil
+}
+
+func (p NamespaceAbsenceProof) namespace() []byte {
+	// Consider extracting celestia-app's namespace package. We can't use it
+	// here because that would introduce a circular import.
+	return append([]byte{uint8(p.NamespaceVersion)}, p.NamespaceId...)
+}
+
+// rowRootSize is the size of an NMT root: the minimum and maximum namespaces
+// followed by the hash.
+const rowRootSize = 2*appconsts.NamespaceSize + sha256.Size
+
+func minNamespace(root []byte) []byte {
+	return nmt.MinNamespace(root, appconsts.NamespaceSize)
+}
+
+func maxNamespace(root []byte) []byte {
+	return nmt.MaxNamespace(root, appconsts.NamespaceSize)
+}
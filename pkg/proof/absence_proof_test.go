@@ -0,0 +1,184 @@
+package proof_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNamespaceAbsenceProof(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	ns3 := appns.MustNewV0(bytes.Repeat([]byte{3}, appns.NamespaceVersionZeroIDSize))
+	ns5 := appns.MustNewV0(bytes.Repeat([]byte{5}, appns.NamespaceVersionZeroIDSize))
+	ns7 := appns.MustNewV0(bytes.Repeat([]byte{7}, appns.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1, ns3, ns5}, []int{5000, 5000, 5000})
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	txs = append(txs, blobTxs...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	type test struct {
+		name      string
+		namespace appns.Namespace
+		expectErr bool
+	}
+	tests := []test{
+		{
+			name:      "namespace between two blobs",
+			namespace: ns2,
+		},
+		{
+			name:      "namespace after the last blob",
+			namespace: ns7,
+		},
+		{
+			name:      "reserved namespace that isn't used",
+			namespace: appns.IntermediateStateRootsNamespace,
+		},
+		{
+			name:      "namespace after the tail padding",
+			namespace: appns.ParitySharesNamespace,
+		},
+		{
+			name:      "transaction namespace is present",
+			namespace: appns.TxNamespace,
+			expectErr: true,
+		},
+		{
+			name:      "blob namespace is present",
+			namespace: ns3,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			absenceProof, err := proof.NewNamespaceAbsenceProofFromEDS(eds, tt.namespace)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, absenceProof.Validate(dah.Hash()))
+			assert.NoError(t, absenceProof.VerifyAgainstDAH(dah))
+
+			// the proof doesn't hold for a namespace that is present
+			absenceProof.NamespaceId = ns3.ID
+			assert.Error(t, absenceProof.VerifyAgainstDAH(dah))
+		})
+	}
+}
+
+func TestNamespaceAbsenceProofRejectsIncompleteProofs(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	ns3 := appns.MustNewV0(bytes.Repeat([]byte{3}, appns.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1, ns3}, []int{5000, 5000})
+	dataSquare, err := square.Construct(coretypes.Txs(blobTxs).ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	validProof := func() proof.NamespaceAbsenceProof {
+		absenceProof, err := proof.NewNamespaceAbsenceProofFromEDS(eds, ns2)
+		require.NoError(t, err)
+		return absenceProof
+	}
+	require.NoError(t, validProof().VerifyAgainstDAH(dah))
+
+	// the rows proven for another namespace don't cover the namespace.
+	absenceProof, err := proof.NewNamespaceAbsenceProofFromEDS(eds, appns.ParitySharesNamespace)
+	require.NoError(t, err)
+	absenceProof.NamespaceId = ns2.ID
+	absenceProof.NamespaceVersion = uint32(ns2.Version)
+	assert.Error(t, absenceProof.Validate(dah.Hash()))
+
+	// an empty NMT proof doesn't prove the absence of a namespace in the range
+	// of the row.
+	absenceProof = validProof()
+	absenceProof.AbsenceProofs[0] = &proof.NMTProof{}
+	assert.Error(t, absenceProof.Validate(dah.Hash()))
+
+	// the proof must match the data root.
+	absenceProof = validProof()
+	assert.Error(t, absenceProof.Validate(bytes.Repeat([]byte{1}, 32)))
+
+	// the proof must contain a valid NMT proof per row.
+	absenceProof = validProof()
+	absenceProof.AbsenceProofs = absenceProof.AbsenceProofs[1:]
+	assert.Error(t, absenceProof.Validate(dah.Hash()))
+
+	// the proof must be for a valid namespace.
+	absenceProof = validProof()
+	absenceProof.NamespaceVersion = 256
+	assert.Error(t, absenceProof.Validate(dah.Hash()))
+}
+
+func TestQueryNamespaceAbsenceProof(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1}, []int{500})
+	block := tmproto.Block{
+		Header: tmproto.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}},
+		Data:   tmproto.Data{Txs: coretypes.Txs(blobTxs).ToSliceOfBytes()},
+	}
+	rawBlock, err := block.Marshal()
+	require.NoError(t, err)
+	req := abci.RequestQuery{Data: rawBlock}
+
+	rawProof, err := proof.QueryNamespaceAbsenceProof(sdk.Context{}, []string{hex.EncodeToString(ns2.Bytes())}, req)
+	require.NoError(t, err)
+	var absenceProof proof.NamespaceAbsenceProof
+	require.NoError(t, absenceProof.Unmarshal(rawProof))
+
+	dataSquare, err := square.Construct(block.Data.Txs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	assert.NoError(t, absenceProof.VerifyAgainstDAH(dah))
+
+	_, err = proof.QueryNamespaceAbsenceProof(sdk.Context{}, []string{hex.EncodeToString(ns1.Bytes())}, req)
+	assert.Error(t, err)
+
+	_, err = proof.QueryNamespaceAbsenceProof(sdk.Context{}, []string{"not hex"}, req)
+	assert.Error(t, err)
+}
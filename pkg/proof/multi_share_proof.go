@@ -0,0 +1,258 @@
+package proof
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/inclusion"
+	"github.com/celestiaorg/go-square/merkle"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// NewMultiShareProof takes an ODS, extends it, then returns an NMT inclusion
+// proof for each of the share ranges, along with the inclusion proofs of the
+// rows containing them to the data root.
+func NewMultiShareProof(dataSquare square.Square, shareRanges []shares.Range) (MultiShareProof, error) {
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return MultiShareProof{}, err
+	}
+	return NewMultiShareProofFromEDS(eds, shareRanges)
+}
+
+// NewMultiShareProofFromEDS takes an extended data square and returns an NMT
+// inclusion proof for each of the share ranges, along with the inclusion
+// proofs of the rows containing them to the data root. Each share range must
+// belong to a single namespace, but different share ranges can belong to
+// different namespaces. The row proofs are included once, even if several
+// share ranges are in the same row.
+func NewMultiShareProofFromEDS(
+	eds *rsmt2d.ExtendedDataSquare,
+	shareRanges []shares.Range,
+) (MultiShareProof, error) {
+	return newMultiShareProof(eds, nil, shareRanges)
+}
+
+// NewMultiShareProofFromCache is like NewMultiShareProofFromEDS, but generates
+// the NMT proofs from the row trees cached by the cacher instead of rebuilding
+// them. The cacher must be the tree constructor of the extended data square,
+// and the rows containing the shares must not be evicted.
+func NewMultiShareProofFromCache(
+	eds *rsmt2d.ExtendedDataSquare,
+	cacher *inclusion.EDSSubTreeRootCacher,
+	shareRanges []shares.Range,
+) (MultiShareProof, error) {
+	return newMultiShareProof(eds, cacher, shareRanges)
+}
+
+func newMultiShareProof(
+	eds *rsmt2d.ExtendedDataSquare,
+	cacher *inclusion.EDSSubTreeRootCacher,
+	shareRanges []shares.Range,
+) (MultiShareProof, error) {
+	if len(shareRanges) == 0 {
+		return MultiShareProof{}, errors.New("no share range to prove")
+	}
+	squareSize := int(eds.Width() / 2)
+	odsShares, err := shares.FromBytes(eds.FlattenedODS())
+	if err != nil {
+		return MultiShareProof{}, err
+	}
+
+	edsRowRoots, err := eds.RowRoots()
+	if err != nil {
+		return MultiShareProof{}, err
+	}
+
+	edsColRoots, err := eds.ColRoots()
+	if err != nil {
+		return MultiShareProof{}, err
+	}
+
+	// the prover is shared between the share ranges so that each row tree is
+	// built at most once.
+	prover := newRowProver(eds, edsRowRoots, cacher)
+	provenRows := make(map[int]struct{})
+
+	shareRangeProofs := make([]*ShareRangeProof, 0, len(shareRanges))
+	for _, shareRange := range shareRanges {
+		if shareRange.End <= shareRange.Start {
+			return MultiShareProof{}, fmt.Errorf("invalid share range [%d, %d)", shareRange.Start, shareRange.End)
+		}
+		namespace, err := ParseNamespace(odsShares, shareRange.Start, shareRange.End)
+		if err != nil {
+			return MultiShareProof{}, err
+		}
+
+		startRow := shareRange.Start / squareSize
+		endRow := (shareRange.End - 1) / squareSize
+		startLeaf := shareRange.Start % squareSize
+		endLeaf := (shareRange.End - 1) % squareSize
+
+		rangeProof := &ShareRangeProof{
+			NamespaceId:      namespace.ID,
+			NamespaceVersion: uint32(namespace.Version),
+			StartRow:         uint32(startRow),
+			EndRow:           uint32(endRow),
+		}
+		for row := startRow; row <= endRow; row++ {
+			provenRows[row] = struct{}{}
+
+			startLeafPos := startLeaf
+			endLeafPos := endLeaf
+			// if this is not the first row, then start with the first leaf
+			if row > startRow {
+				startLeafPos = 0
+			}
+			// if this is not the last row, then select for the rest of the row
+			if row != endRow {
+				endLeafPos = squareSize - 1
+			}
+
+			rangeProof.Data = append(rangeProof.Data, shares.ToBytes(odsShares[row*squareSize+startLeafPos:row*squareSize+endLeafPos+1])...)
+			proof, err := prover.ProveRange(uint(row), startLeafPos, endLeafPos+1)
+			if err != nil {
+				return MultiShareProof{}, err
+			}
+			rangeProof.ShareProofs = append(rangeProof.ShareProofs, &NMTProof{
+				Start:    int32(proof.Start()),
+				End:      int32(proof.End()),
+				Nodes:    proof.Nodes(),
+				LeafHash: proof.LeafHash(),
+			})
+		}
+		shareRangeProofs = append(shareRangeProofs, rangeProof)
+	}
+
+	rows := make([]int, 0, len(provenRows))
+	for row := range provenRows {
+		rows = append(rows, row)
+	}
+	sort.Ints(rows)
+
+	// create the binary merkle inclusion proof for the rows to the data root
+	_, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, edsColRoots...))
+	rowRoots := make([][]byte, len(rows))
+	rowProofs := make([]*Proof, len(rows))
+	for i, row := range rows {
+		rowRoots[i] = edsRowRoots[row]
+		rowProofs[i] = &Proof{
+			Total:    allProofs[row].Total,
+			Index:    allProofs[row].Index,
+			LeafHash: allProofs[row].LeafHash,
+			Aunts:    allProofs[row].Aunts,
+		}
+	}
+
+	return MultiShareProof{
+		ShareRangeProofs: shareRangeProofs,
+		RowRoots:         rowRoots,
+		RowProofs:        rowProofs,
+	}, nil
+}
+
+// Validate runs basic validations on the proof then verifies if it is
+// consistent. It returns nil if the proof is valid. Otherwise, it returns a
+// sensible error. The `root` is the block data root that the shares to be
+// proven belong to.
+func (msp MultiShareProof) Validate(root []byte) error {
+	if len(msp.ShareRangeProofs) == 0 {
+		return errors.New("empty multi share proof")
+	}
+	if len(msp.RowProofs) != len(msp.RowRoots) {
+		return fmt.Errorf("the number of row proofs %d must equal the number of row roots %d", len(msp.RowProofs), len(msp.RowRoots))
+	}
+
+	// verify the row roots once, then index them by row.
+	rowRoots := make(map[uint32][]byte, len(msp.RowRoots))
+	for i, proof := range msp.RowProofs {
+		if i > 0 && proof.Index <= msp.RowProofs[i-1].Index {
+			return errors.New("row proofs must be in strictly ascending row order")
+		}
+		// the data root commits to the row roots followed by the column roots
+		// of the EDS, so only the first half of its leaves are rows.
+		if proof.Index < 0 || proof.Index >= proof.Total/2 {
+			return fmt.Errorf("row proof index %d is not a row", proof.Index)
+		}
+		if err := proof.Verify(root, msp.RowRoots[i]); err != nil {
+			return fmt.Errorf("row proof %d failed to verify: %w", i, err)
+		}
+		rowRoots[uint32(proof.Index)] = msp.RowRoots[i]
+	}
+
+	for i, rangeProof := range msp.ShareRangeProofs {
+		if err := rangeProof.validate(rowRoots); err != nil {
+			return fmt.Errorf("share range proof %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validate verifies the share range proof against the verified row roots,
+// indexed by row.
+func (srp ShareRangeProof) validate(rowRoots map[uint32][]byte) error {
+	if len(srp.Data) == 0 {
+		return errors.New("empty share range proof")
+	}
+	if srp.EndRow < srp.StartRow {
+		return fmt.Errorf("end row %d cannot be lower than start row %d", srp.EndRow, srp.StartRow)
+	}
+	if int(srp.EndRow-srp.StartRow+1) != len(srp.ShareProofs) {
+		return fmt.Errorf("the number of share proofs %d must equal the number of rows %d", len(srp.ShareProofs), int(srp.EndRow-srp.StartRow+1))
+	}
+	if srp.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("invalid namespace version %d", srp.NamespaceVersion)
+	}
+
+	numberOfSharesInProofs := 0
+	for _, proof := range srp.ShareProofs {
+		if proof.Start < 0 {
+			return errors.New("proof index cannot be negative")
+		}
+		if (proof.End - proof.Start) <= 0 {
+			return errors.New("proof total must be positive")
+		}
+		// the range is not inclusive from the left.
+		numberOfSharesInProofs += int(proof.End - proof.Start)
+	}
+	if len(srp.Data) != numberOfSharesInProofs {
+		return fmt.Errorf("the number of shares %d must equal the number of shares in share proofs %d", len(srp.Data), numberOfSharesInProofs)
+	}
+
+	// Consider extracting celestia-app's namespace package. We can't use it
+	// here because that would introduce a circular import.
+	namespace := append([]byte{uint8(srp.NamespaceVersion)}, srp.NamespaceId...)
+	cursor := 0
+	for i, proof := range srp.ShareProofs {
+		row := srp.StartRow + uint32(i)
+		rowRoot, ok := rowRoots[row]
+		if !ok {
+			return fmt.Errorf("missing row proof for row %d", row)
+		}
+		nmtProof := nmt.NewInclusionProof(
+			int(proof.Start),
+			int(proof.End),
+			proof.Nodes,
+			true,
+		)
+		sharesUsed := int(proof.End - proof.Start)
+		valid := nmtProof.VerifyInclusion(
+			appconsts.NewBaseHashFunc(),
+			namespace,
+			srp.Data[cursor:cursor+sharesUsed],
+			rowRoot,
+		)
+		if !valid {
+			return fmt.Errorf("share proof for row %d failed to verify", row)
+		}
+		cursor += sharesUsed
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package proof_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/v2/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMultiShareProof(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1, ns2}, []int{5000, 5000})
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	txs = append(txs, blobTxs...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	ns1Range := namespaceRange(t, dataSquare, ns1)
+	ns2Range := namespaceRange(t, dataSquare, ns2)
+
+	type test struct {
+		name        string
+		shareRanges []shares.Range
+		expectErr   bool
+	}
+	tests := []test{
+		{
+			name:        "single range",
+			shareRanges: []shares.Range{shares.NewRange(0, 10)},
+		},
+		{
+			name:        "ranges of different namespaces",
+			shareRanges: []shares.Range{shares.NewRange(0, 10), ns1Range, ns2Range},
+		},
+		{
+			name:        "overlapping ranges of the same namespace",
+			shareRanges: []shares.Range{ns1Range, shares.NewRange(ns1Range.Start+1, ns1Range.End)},
+		},
+		{
+			name:        "no ranges",
+			shareRanges: nil,
+			expectErr:   true,
+		},
+		{
+			name:        "empty range",
+			shareRanges: []shares.Range{ns1Range, shares.NewRange(3, 3)},
+			expectErr:   true,
+		},
+		{
+			name:        "range with different namespaces",
+			shareRanges: []shares.Range{shares.NewRange(ns1Range.Start, ns2Range.End)},
+			expectErr:   true,
+		},
+		{
+			name:        "range outside of the square",
+			shareRanges: []shares.Range{shares.NewRange(0, len(dataSquare)+1)},
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			multiShareProof, err := proof.NewMultiShareProofFromEDS(eds, tt.shareRanges)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, multiShareProof.ShareRangeProofs, len(tt.shareRanges))
+			assert.NoError(t, multiShareProof.Validate(dataRoot))
+
+			// the shares of each range are the ones of the square
+			for i, shareRange := range tt.shareRanges {
+				assert.Equal(t, shares.ToBytes(dataSquare[shareRange.Start:shareRange.End]), multiShareProof.ShareRangeProofs[i].Data)
+			}
+
+			// the rows shared by several ranges are proven once
+			rows := make(map[int64]bool)
+			for _, rowProof := range multiShareProof.RowProofs {
+				assert.False(t, rows[rowProof.Index])
+				rows[rowProof.Index] = true
+			}
+		})
+	}
+}
+
+func TestMultiShareProofValidate(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1}, []int{5000})
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	txs = append(txs, blobTxs...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	dah := dataAvailabilityHeader(t, dataSquare)
+
+	validProof := func() proof.MultiShareProof {
+		multiShareProof, err := proof.NewMultiShareProof(dataSquare, []shares.Range{shares.NewRange(0, 10), namespaceRange(t, dataSquare, ns1)})
+		require.NoError(t, err)
+		return multiShareProof
+	}
+	require.NoError(t, validProof().Validate(dah.Hash()))
+
+	type test struct {
+		name   string
+		modify func(*proof.MultiShareProof)
+	}
+	tests := []test{
+		{
+			name:   "wrong data",
+			modify: func(p *proof.MultiShareProof) { p.ShareRangeProofs[1].Data[0] = p.ShareRangeProofs[0].Data[0] },
+		},
+		{
+			name:   "wrong namespace",
+			modify: func(p *proof.MultiShareProof) { p.ShareRangeProofs[1].NamespaceId = p.ShareRangeProofs[0].NamespaceId },
+		},
+		{
+			name: "missing row proof",
+			modify: func(p *proof.MultiShareProof) {
+				p.RowRoots = p.RowRoots[:len(p.RowRoots)-1]
+				p.RowProofs = p.RowProofs[:len(p.RowProofs)-1]
+			},
+		},
+		{
+			name:   "mismatched row roots",
+			modify: func(p *proof.MultiShareProof) { p.RowRoots = p.RowRoots[1:] },
+		},
+		{
+			name:   "wrong row root",
+			modify: func(p *proof.MultiShareProof) { p.RowRoots[0] = p.RowRoots[len(p.RowRoots)-1] },
+		},
+		{
+			name:   "wrong row range",
+			modify: func(p *proof.MultiShareProof) { p.ShareRangeProofs[1].EndRow++ },
+		},
+		{
+			name:   "no share range proofs",
+			modify: func(p *proof.MultiShareProof) { p.ShareRangeProofs = nil },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			multiShareProof := validProof()
+			tt.modify(&multiShareProof)
+			assert.Error(t, multiShareProof.Validate(dah.Hash()))
+		})
+	}
+}
+
+func TestQueryMultiShareInclusionProof(t *testing.T) {
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	block := tmproto.Block{
+		Header: tmproto.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}},
+		Data:   tmproto.Data{Txs: txs.ToSliceOfBytes()},
+	}
+	rawBlock, err := block.Marshal()
+	require.NoError(t, err)
+	req := abci.RequestQuery{Data: rawBlock}
+
+	rawProof, err := proof.QueryMultiShareInclusionProof(sdk.Context{}, []string{"0", "2", "5", "7"}, req)
+	require.NoError(t, err)
+	var multiShareProof proof.MultiShareProof
+	require.NoError(t, multiShareProof.Unmarshal(rawProof))
+	require.Len(t, multiShareProof.ShareRangeProofs, 2)
+
+	dataSquare, err := square.Construct(block.Data.Txs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	dah := dataAvailabilityHeader(t, dataSquare)
+	assert.NoError(t, multiShareProof.Validate(dah.Hash()))
+
+	_, err = proof.QueryMultiShareInclusionProof(sdk.Context{}, []string{"0", "2", "5"}, req)
+	assert.Error(t, err)
+
+	_, err = proof.QueryMultiShareInclusionProof(sdk.Context{}, []string{"0", "-2"}, req)
+	assert.Error(t, err)
+}
+
+// namespaceRange returns the range of the shares of the namespace in the
+// square.
+// TestProofsFromCache checks that the proofs generated from the row trees
+// cached while computing the roots of the square are identical to the ones
+// generated by rebuilding the row trees.
+func TestProofsFromCache(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1}, []int{5000})
+	txs := append(testfactory.GenerateRandomTxs(50, 500), blobTxs...)
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	cacher := inclusion.NewSubtreeCacher(uint64(dataSquare.Size()))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares.ToBytes(dataSquare), appconsts.DefaultCodec(), cacher.Constructor)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	ns1Range := namespaceRange(t, dataSquare, ns1)
+
+	want, err := proof.NewShareInclusionProofFromEDS(eds, ns1, ns1Range)
+	require.NoError(t, err)
+	got, err := proof.NewShareInclusionProofFromCache(eds, cacher, ns1, ns1Range)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.NoError(t, got.Validate(dah.Hash()))
+
+	ranges := []shares.Range{shares.NewRange(0, 10), ns1Range}
+	wantMulti, err := proof.NewMultiShareProofFromEDS(eds, ranges)
+	require.NoError(t, err)
+	gotMulti, err := proof.NewMultiShareProofFromCache(eds, cacher, ranges)
+	require.NoError(t, err)
+	assert.Equal(t, wantMulti, gotMulti)
+
+	// the proofs can't be generated once the rows are evicted
+	cacher.Evict(uint(ns1Range.Start / dataSquare.Size()))
+	_, err = proof.NewShareInclusionProofFromCache(eds, cacher, ns1, ns1Range)
+	assert.Error(t, err)
+}
+
+func namespaceRange(t *testing.T, dataSquare square.Square, namespace appns.Namespace) shares.Range {
+	start, end := -1, -1
+	for i, share := range dataSquare {
+		ns, err := share.Namespace()
+		require.NoError(t, err)
+		if ns.Equals(namespace) {
+			if start == -1 {
+				start = i
+			}
+			end = i + 1
+		}
+	}
+	require.NotEqual(t, -1, start)
+	return shares.NewRange(start, end)
+}
+
+func dataAvailabilityHeader(t *testing.T, dataSquare square.Square) da.DataAvailabilityHeader {
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return dah
+}
@@ -1,8 +1,6 @@
 package proof
 
 import (
-	"bytes"
-	"errors"
 	"fmt"
 	"math"
 
@@ -10,7 +8,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v2/pkg/da"
-	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	"github.com/celestiaorg/celestia-app/v2/pkg/inclusion"
 	"github.com/celestiaorg/go-square/blob"
 	"github.com/celestiaorg/go-square/merkle"
 	appns "github.com/celestiaorg/go-square/namespace"
@@ -80,6 +78,28 @@ func NewShareInclusionProofFromEDS(
 	eds *rsmt2d.ExtendedDataSquare,
 	namespace appns.Namespace,
 	shareRange shares.Range,
+) (ShareProof, error) {
+	return newShareInclusionProof(eds, nil, namespace, shareRange)
+}
+
+// NewShareInclusionProofFromCache is like NewShareInclusionProofFromEDS, but
+// generates the NMT proofs from the row trees cached by the cacher instead of
+// rebuilding them. The cacher must be the tree constructor of the extended
+// data square, and the rows containing the shares must not be evicted.
+func NewShareInclusionProofFromCache(
+	eds *rsmt2d.ExtendedDataSquare,
+	cacher *inclusion.EDSSubTreeRootCacher,
+	namespace appns.Namespace,
+	shareRange shares.Range,
+) (ShareProof, error) {
+	return newShareInclusionProof(eds, cacher, namespace, shareRange)
+}
+
+func newShareInclusionProof(
+	eds *rsmt2d.ExtendedDataSquare,
+	cacher *inclusion.EDSSubTreeRootCacher,
+	namespace appns.Namespace,
+	shareRange shares.Range,
 ) (ShareProof, error) {
 	squareSize := square.Size(len(eds.FlattenedODS()))
 	startRow := shareRange.Start / squareSize
@@ -121,30 +141,10 @@ func NewShareInclusionProofFromEDS(
 		rows[i-startRow] = shares
 	}
 
+	prover := newRowProver(eds, edsRowRoots, cacher)
 	var shareProofs []*NMTProof //nolint:prealloc
 	var rawShares [][]byte
 	for i, row := range rows {
-		// create an nmt to generate a proof.
-		// we have to re-create the tree as the eds one is not accessible.
-		tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(i))
-		for _, share := range row {
-			err := tree.Push(
-				share.ToBytes(),
-			)
-			if err != nil {
-				return ShareProof{}, err
-			}
-		}
-
-		// make sure that the generated root is the same as the eds row root.
-		root, err := tree.Root()
-		if err != nil {
-			return ShareProof{}, err
-		}
-		if !bytes.Equal(rowRoots[i], root) {
-			return ShareProof{}, errors.New("eds row root is different than tree root")
-		}
-
 		startLeafPos := startLeaf
 		endLeafPos := endLeaf
 
@@ -158,7 +158,7 @@ func NewShareInclusionProofFromEDS(
 		}
 
 		rawShares = append(rawShares, shares.ToBytes(row[startLeafPos:endLeafPos+1])...)
-		proof, err := tree.ProveRange(startLeafPos, endLeafPos+1)
+		proof, err := prover.ProveRange(uint(startRow+i), startLeafPos, endLeafPos+1)
 		if err != nil {
 			return ShareProof{}, err
 		}
@@ -100,6 +100,237 @@ func (m *ShareProof) GetNamespaceVersion() uint32 {
 	return 0
 }
 
+// MultiShareProof is a set of NMT proofs for several share ranges, possibly
+// belonging to different namespaces, and a Merkle proof that the rows
+// containing them exist in a Merkle tree with a given data root. The row proofs
+// are shared between the share ranges.
+type MultiShareProof struct {
+	ShareRangeProofs []*ShareRangeProof `protobuf:"bytes,1,rep,name=share_range_proofs,json=shareRangeProofs,proto3" json:"share_range_proofs,omitempty"`
+	// RowRoots are the roots of the rows containing the shares, in ascending row
+	// order. Each row appears once.
+	RowRoots [][]byte `protobuf:"bytes,2,rep,name=row_roots,json=rowRoots,proto3" json:"row_roots,omitempty"`
+	// RowProofs are the Merkle proofs of the row roots to the data root. The
+	// index of each proof is the index of its row.
+	RowProofs []*Proof `protobuf:"bytes,3,rep,name=row_proofs,json=rowProofs,proto3" json:"row_proofs,omitempty"`
+}
+
+func (m *MultiShareProof) Reset()         { *m = MultiShareProof{} }
+func (m *MultiShareProof) String() string { return proto.CompactTextString(m) }
+func (*MultiShareProof) ProtoMessage()    {}
+func (*MultiShareProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{1}
+}
+func (m *MultiShareProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiShareProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiShareProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiShareProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiShareProof.Merge(m, src)
+}
+func (m *MultiShareProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiShareProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiShareProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiShareProof proto.InternalMessageInfo
+
+func (m *MultiShareProof) GetShareRangeProofs() []*ShareRangeProof {
+	if m != nil {
+		return m.ShareRangeProofs
+	}
+	return nil
+}
+
+func (m *MultiShareProof) GetRowRoots() [][]byte {
+	if m != nil {
+		return m.RowRoots
+	}
+	return nil
+}
+
+func (m *MultiShareProof) GetRowProofs() []*Proof {
+	if m != nil {
+		return m.RowProofs
+	}
+	return nil
+}
+
+// ShareRangeProof is an NMT proof that a contiguous range of shares of a single
+// namespace exist in a set of rows.
+type ShareRangeProof struct {
+	Data             [][]byte    `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	ShareProofs      []*NMTProof `protobuf:"bytes,2,rep,name=share_proofs,json=shareProofs,proto3" json:"share_proofs,omitempty"`
+	NamespaceId      []byte      `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	NamespaceVersion uint32      `protobuf:"varint,4,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	// StartRow is the index of the first row containing the shares.
+	StartRow uint32 `protobuf:"varint,5,opt,name=start_row,json=startRow,proto3" json:"start_row,omitempty"`
+	// EndRow is the index of the last row containing the shares.
+	EndRow uint32 `protobuf:"varint,6,opt,name=end_row,json=endRow,proto3" json:"end_row,omitempty"`
+}
+
+func (m *ShareRangeProof) Reset()         { *m = ShareRangeProof{} }
+func (m *ShareRangeProof) String() string { return proto.CompactTextString(m) }
+func (*ShareRangeProof) ProtoMessage()    {}
+func (*ShareRangeProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{2}
+}
+func (m *ShareRangeProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareRangeProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareRangeProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareRangeProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareRangeProof.Merge(m, src)
+}
+func (m *ShareRangeProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareRangeProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareRangeProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareRangeProof proto.InternalMessageInfo
+
+func (m *ShareRangeProof) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ShareRangeProof) GetShareProofs() []*NMTProof {
+	if m != nil {
+		return m.ShareProofs
+	}
+	return nil
+}
+
+func (m *ShareRangeProof) GetNamespaceId() []byte {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return nil
+}
+
+func (m *ShareRangeProof) GetNamespaceVersion() uint32 {
+	if m != nil {
+		return m.NamespaceVersion
+	}
+	return 0
+}
+
+func (m *ShareRangeProof) GetStartRow() uint32 {
+	if m != nil {
+		return m.StartRow
+	}
+	return 0
+}
+
+func (m *ShareRangeProof) GetEndRow() uint32 {
+	if m != nil {
+		return m.EndRow
+	}
+	return 0
+}
+
+// NamespaceAbsenceProof is a proof that a namespace has no shares in the
+// original data square of a block. It contains the NMT absence proofs of the
+// rows whose namespace ranges would contain the namespace, and a Merkle proof
+// that those rows exist in a Merkle tree with a given data root.
+type NamespaceAbsenceProof struct {
+	NamespaceId      []byte `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	NamespaceVersion uint32 `protobuf:"varint,2,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	// RowProof proves the rows bounding the namespace to the data root.
+	RowProof *RowProof `protobuf:"bytes,3,opt,name=row_proof,json=rowProof,proto3" json:"row_proof,omitempty"`
+	// AbsenceProofs contains one NMT proof per row of the row proof. The proofs
+	// of the rows whose namespace range doesn't contain the namespace are empty.
+	AbsenceProofs []*NMTProof `protobuf:"bytes,4,rep,name=absence_proofs,json=absenceProofs,proto3" json:"absence_proofs,omitempty"`
+}
+
+func (m *NamespaceAbsenceProof) Reset()         { *m = NamespaceAbsenceProof{} }
+func (m *NamespaceAbsenceProof) String() string { return proto.CompactTextString(m) }
+func (*NamespaceAbsenceProof) ProtoMessage()    {}
+func (*NamespaceAbsenceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{3}
+}
+func (m *NamespaceAbsenceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceAbsenceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceAbsenceProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceAbsenceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceAbsenceProof.Merge(m, src)
+}
+func (m *NamespaceAbsenceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceAbsenceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceAbsenceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceAbsenceProof proto.InternalMessageInfo
+
+func (m *NamespaceAbsenceProof) GetNamespaceId() []byte {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return nil
+}
+
+func (m *NamespaceAbsenceProof) GetNamespaceVersion() uint32 {
+	if m != nil {
+		return m.NamespaceVersion
+	}
+	return 0
+}
+
+func (m *NamespaceAbsenceProof) GetRowProof() *RowProof {
+	if m != nil {
+		return m.RowProof
+	}
+	return nil
+}
+
+func (m *NamespaceAbsenceProof) GetAbsenceProofs() []*NMTProof {
+	if m != nil {
+		return m.AbsenceProofs
+	}
+	return nil
+}
+
 // RowProof is a Merkle proof that a set of rows exist in a Merkle tree with a
 // given data root.
 type RowProof struct {
@@ -114,7 +345,7 @@ func (m *RowProof) Reset()         { *m = RowProof{} }
 func (m *RowProof) String() string { return proto.CompactTextString(m) }
 func (*RowProof) ProtoMessage()    {}
 func (*RowProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e53d87d8fb5ec353, []int{1}
+	return fileDescriptor_e53d87d8fb5ec353, []int{4}
 }
 func (m *RowProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +435,7 @@ func (m *NMTProof) Reset()         { *m = NMTProof{} }
 func (m *NMTProof) String() string { return proto.CompactTextString(m) }
 func (*NMTProof) ProtoMessage()    {}
 func (*NMTProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e53d87d8fb5ec353, []int{2}
+	return fileDescriptor_e53d87d8fb5ec353, []int{5}
 }
 func (m *NMTProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,7 +504,7 @@ func (m *Proof) Reset()         { *m = Proof{} }
 func (m *Proof) String() string { return proto.CompactTextString(m) }
 func (*Proof) ProtoMessage()    {}
 func (*Proof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e53d87d8fb5ec353, []int{3}
+	return fileDescriptor_e53d87d8fb5ec353, []int{6}
 }
 func (m *Proof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,6 +563,9 @@ func (m *Proof) GetAunts() [][]byte {
 
 func init() {
 	proto.RegisterType((*ShareProof)(nil), "celestia.core.v1.proof.ShareProof")
+	proto.RegisterType((*MultiShareProof)(nil), "celestia.core.v1.proof.MultiShareProof")
+	proto.RegisterType((*ShareRangeProof)(nil), "celestia.core.v1.proof.ShareRangeProof")
+	proto.RegisterType((*NamespaceAbsenceProof)(nil), "celestia.core.v1.proof.NamespaceAbsenceProof")
 	proto.RegisterType((*RowProof)(nil), "celestia.core.v1.proof.RowProof")
 	proto.RegisterType((*NMTProof)(nil), "celestia.core.v1.proof.NMTProof")
 	proto.RegisterType((*Proof)(nil), "celestia.core.v1.proof.Proof")
@@ -342,35 +576,43 @@ func init() {
 }
 
 var fileDescriptor_e53d87d8fb5ec353 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0xad, 0x37, 0x6d, 0x09, 0x6e, 0x56, 0x5a, 0x2c, 0x04, 0x96, 0x10, 0x51, 0xc8, 0x29, 0x12,
-	0xda, 0x44, 0x0b, 0xe2, 0xc8, 0x05, 0x0e, 0xc0, 0x01, 0x84, 0x0c, 0xe2, 0xc0, 0xa5, 0xf2, 0x36,
-	0x6e, 0x13, 0xd1, 0xcd, 0x44, 0xb6, 0xb7, 0xe1, 0x33, 0xf8, 0x0c, 0x3e, 0x85, 0x63, 0x8f, 0x1c,
-	0x51, 0xfb, 0x0b, 0x7c, 0x00, 0xf2, 0xb8, 0x09, 0xaa, 0x04, 0x7b, 0xb1, 0xe6, 0xbd, 0x99, 0x79,
-	0x6f, 0x66, 0x64, 0x9a, 0x2e, 0xd4, 0x5a, 0x19, 0x5b, 0xcb, 0x62, 0x01, 0x5a, 0x15, 0x9b, 0x8b,
-	0xa2, 0xd5, 0x00, 0x4b, 0xff, 0xe6, 0xad, 0x06, 0x0b, 0xec, 0x5e, 0x5f, 0x93, 0xbb, 0x9a, 0x7c,
-	0x73, 0x91, 0x63, 0x36, 0xfd, 0x4d, 0x28, 0xfd, 0x50, 0x49, 0xad, 0xde, 0x3b, 0xc8, 0x18, 0x1d,
-	0x97, 0xd2, 0x4a, 0x4e, 0x92, 0x20, 0x8b, 0x04, 0xc6, 0xec, 0x25, 0x8d, 0x8c, 0xab, 0x98, 0x63,
-	0x87, 0xe1, 0x27, 0x49, 0x90, 0xcd, 0x9e, 0x24, 0xf9, 0xbf, 0x15, 0xf3, 0x77, 0x6f, 0x3f, 0xa2,
-	0x96, 0x98, 0x99, 0x41, 0xd7, 0xb0, 0x47, 0x34, 0x6a, 0xe4, 0x95, 0x32, 0xad, 0x5c, 0xa8, 0x79,
-	0x5d, 0xf2, 0x20, 0x21, 0x59, 0x24, 0x66, 0x03, 0xf7, 0xa6, 0x64, 0xcf, 0xe9, 0x6d, 0x0d, 0x9d,
-	0x77, 0xe1, 0xe3, 0x84, 0xdc, 0x64, 0x22, 0xa0, 0xf3, 0x26, 0xa1, 0x3e, 0x44, 0xec, 0x31, 0xbd,
-	0xf3, 0xd7, 0x61, 0xa3, 0xb4, 0xa9, 0xa1, 0xe1, 0x93, 0x84, 0x64, 0xa7, 0xe2, 0x6c, 0x48, 0x7c,
-	0xf2, 0x7c, 0xfa, 0x9d, 0xd0, 0xb0, 0xd7, 0x60, 0x0f, 0xbc, 0xb1, 0x06, 0xb0, 0xe6, 0xb0, 0xb9,
-	0x93, 0x15, 0x0e, 0xb3, 0x67, 0x74, 0x7a, 0xb4, 0xf7, 0xc3, 0xff, 0x8d, 0xe4, 0xe7, 0x39, 0x14,
-	0xbb, 0x43, 0x3a, 0xbd, 0xc3, 0x9e, 0x18, 0x3b, 0x1f, 0x63, 0xa5, 0xb6, 0x73, 0x0d, 0x1d, 0x2e,
-	0x78, 0x2a, 0x42, 0x24, 0x04, 0x74, 0xec, 0x3e, 0xbd, 0xa5, 0x9a, 0x12, 0x53, 0x7e, 0xe8, 0xa9,
-	0x6a, 0x4a, 0x01, 0x5d, 0xaa, 0x68, 0xd8, 0x9f, 0x94, 0xdd, 0xa5, 0x13, 0x6c, 0xe0, 0x24, 0x21,
-	0xd9, 0x44, 0x78, 0xc0, 0xce, 0x68, 0xa0, 0x9a, 0x92, 0x9f, 0x20, 0xe7, 0x42, 0x57, 0xd7, 0x40,
-	0xa9, 0x0c, 0x0f, 0x70, 0x1b, 0x0f, 0x9c, 0xff, 0x5a, 0xc9, 0xe5, 0xbc, 0x92, 0xa6, 0x42, 0xff,
-	0x48, 0x84, 0x8e, 0x78, 0x2d, 0x4d, 0x95, 0x2e, 0xe9, 0x64, 0xf0, 0xb0, 0x60, 0xe5, 0x1a, 0x3d,
-	0x02, 0xe1, 0x81, 0x63, 0xeb, 0xa6, 0x54, 0x5f, 0xd1, 0x25, 0x10, 0x1e, 0x1c, 0x2b, 0x06, 0xc7,
-	0x8a, 0xae, 0x45, 0x5e, 0x37, 0xd6, 0xf0, 0xb1, 0x1f, 0x02, 0xc1, 0x8b, 0x57, 0x3f, 0x76, 0x31,
-	0xd9, 0xee, 0x62, 0xf2, 0x6b, 0x17, 0x93, 0x6f, 0xfb, 0x78, 0xb4, 0xdd, 0xc7, 0xa3, 0x9f, 0xfb,
-	0x78, 0xf4, 0xf9, 0x7c, 0x55, 0xdb, 0xea, 0xfa, 0x32, 0x5f, 0xc0, 0x55, 0xd1, 0xdf, 0x18, 0xf4,
-	0x6a, 0x88, 0xcf, 0x65, 0xdb, 0x16, 0xed, 0x97, 0x95, 0xff, 0xd7, 0x97, 0x53, 0xfc, 0xd8, 0x4f,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x27, 0x8a, 0x77, 0xfe, 0x02, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xbd, 0x8e, 0xd3, 0x40,
+	0x10, 0xce, 0x9e, 0xe3, 0x90, 0x9b, 0xf8, 0xb8, 0xb0, 0xe2, 0xc7, 0x12, 0xc2, 0x32, 0x6e, 0x88,
+	0x84, 0xce, 0xd1, 0x81, 0xe8, 0xa0, 0x00, 0x8a, 0x83, 0xe2, 0x4e, 0x68, 0xf9, 0x29, 0x68, 0xa2,
+	0x4d, 0xbc, 0x49, 0x2c, 0x72, 0x5e, 0x6b, 0x77, 0x93, 0xf0, 0x18, 0x3c, 0x06, 0xef, 0x41, 0x43,
+	0x79, 0x25, 0x25, 0x4a, 0x1e, 0x00, 0x0a, 0x1e, 0x00, 0xed, 0x6e, 0x6c, 0xce, 0x51, 0x02, 0x47,
+	0x75, 0x8d, 0x35, 0x33, 0x3b, 0x33, 0xdf, 0x7c, 0xf3, 0x8d, 0x0c, 0xd1, 0x80, 0x4d, 0x98, 0x54,
+	0x29, 0xed, 0x0e, 0xb8, 0x60, 0xdd, 0xd9, 0x61, 0x37, 0x17, 0x9c, 0x0f, 0xed, 0x37, 0xce, 0x05,
+	0x57, 0x1c, 0xdf, 0x2c, 0x72, 0x62, 0x9d, 0x13, 0xcf, 0x0e, 0x63, 0xf3, 0x1a, 0xfd, 0x42, 0x00,
+	0xaf, 0xc7, 0x54, 0xb0, 0x57, 0xda, 0xc5, 0x18, 0xea, 0x09, 0x55, 0xd4, 0x47, 0xa1, 0xd3, 0xf1,
+	0x88, 0xb1, 0xf1, 0x73, 0xf0, 0xa4, 0xce, 0xe8, 0x99, 0x0a, 0xe9, 0xef, 0x84, 0x4e, 0xa7, 0xf5,
+	0x20, 0x8c, 0x37, 0x77, 0x8c, 0x4f, 0x8e, 0xdf, 0x98, 0x5e, 0xa4, 0x25, 0xcb, 0xbe, 0x12, 0xdf,
+	0x05, 0x2f, 0xa3, 0xa7, 0x4c, 0xe6, 0x74, 0xc0, 0x7a, 0x69, 0xe2, 0x3b, 0x21, 0xea, 0x78, 0xa4,
+	0x55, 0xc6, 0x5e, 0x26, 0xf8, 0x09, 0xec, 0x0a, 0x3e, 0xb7, 0x28, 0x7e, 0x3d, 0x44, 0x7f, 0x03,
+	0x21, 0x7c, 0x6e, 0x41, 0x9a, 0x62, 0x65, 0xe1, 0xfb, 0x70, 0xed, 0x0f, 0xc2, 0x8c, 0x09, 0x99,
+	0xf2, 0xcc, 0x77, 0x43, 0xd4, 0xd9, 0x23, 0xed, 0xf2, 0xe1, 0x9d, 0x8d, 0x47, 0x5f, 0x10, 0xec,
+	0x1f, 0x4f, 0x27, 0x2a, 0x3d, 0xc7, 0xfd, 0x2d, 0x60, 0xcb, 0x53, 0xd0, 0x6c, 0x54, 0xb2, 0x45,
+	0x86, 0xed, 0xbd, 0x6d, 0x83, 0x98, 0x7a, 0xa2, 0x0b, 0xec, 0x3c, 0x6d, 0x59, 0x0d, 0x48, 0x7c,
+	0xdb, 0xd2, 0x12, 0x9c, 0x2b, 0xbb, 0x3b, 0xcf, 0x0c, 0x4d, 0xb4, 0x8f, 0x1f, 0x03, 0x94, 0x9c,
+	0xa5, 0xef, 0x18, 0xac, 0x3b, 0xdb, 0xb0, 0x2c, 0xc2, 0x6e, 0xc1, 0x58, 0x46, 0x3f, 0x11, 0xec,
+	0xaf, 0x0d, 0x70, 0xa9, 0x0a, 0x6e, 0x94, 0xa0, 0xbe, 0x59, 0x02, 0xbd, 0x17, 0xa9, 0xa8, 0x50,
+	0x3d, 0xc1, 0xe7, 0x2b, 0x9d, 0x9a, 0x26, 0x40, 0xf8, 0x1c, 0xdf, 0x82, 0x2b, 0x2c, 0x4b, 0xcc,
+	0x53, 0xc3, 0x3c, 0x35, 0x58, 0x96, 0x10, 0x3e, 0x8f, 0x7e, 0x20, 0xb8, 0x71, 0x52, 0xb4, 0x7a,
+	0xda, 0x97, 0x2c, 0x1b, 0xac, 0x88, 0xaf, 0xcf, 0x87, 0x2e, 0x38, 0xdf, 0xce, 0x96, 0xf9, 0x2a,
+	0xe7, 0xe8, 0xfc, 0xf7, 0x39, 0x1e, 0xc1, 0x55, 0x6a, 0xc7, 0x2b, 0xb6, 0x5e, 0xbf, 0xe0, 0xd6,
+	0xf7, 0xe8, 0x39, 0x5a, 0x32, 0xfa, 0x8c, 0xa0, 0x59, 0xf4, 0xaf, 0x1e, 0x13, 0x5a, 0x3b, 0xa6,
+	0x47, 0xd0, 0xa8, 0x08, 0xfc, 0x8f, 0x43, 0x5a, 0x25, 0xeb, 0x8b, 0xd1, 0xfd, 0x56, 0x82, 0x1a,
+	0xbb, 0x2a, 0x4e, 0x7d, 0xbb, 0x38, 0x6e, 0x45, 0x1c, 0x06, 0xcd, 0x82, 0x05, 0xbe, 0x0e, 0xae,
+	0x29, 0x30, 0x3a, 0xb8, 0xc4, 0x3a, 0xb8, 0x0d, 0x0e, 0xcb, 0x12, 0xb3, 0x73, 0x97, 0x68, 0x53,
+	0xe7, 0x65, 0x3c, 0x61, 0xf6, 0xf8, 0x3d, 0x62, 0x1d, 0x8d, 0x3f, 0x61, 0x74, 0xd8, 0x1b, 0x53,
+	0x39, 0x36, 0xf8, 0x1e, 0x69, 0xea, 0xc0, 0x0b, 0x2a, 0xc7, 0xd1, 0x10, 0xdc, 0x12, 0x43, 0x71,
+	0x45, 0x27, 0x06, 0xc3, 0x21, 0xd6, 0xd1, 0xd1, 0x34, 0x4b, 0xd8, 0x47, 0x83, 0xe2, 0x10, 0xeb,
+	0x54, 0x3b, 0x3a, 0xd5, 0x8e, 0xba, 0x84, 0x4e, 0x33, 0x65, 0x35, 0xf2, 0x88, 0x75, 0x9e, 0x1d,
+	0x7d, 0x5d, 0x04, 0xe8, 0x6c, 0x11, 0xa0, 0xef, 0x8b, 0x00, 0x7d, 0x5a, 0x06, 0xb5, 0xb3, 0x65,
+	0x50, 0xfb, 0xb6, 0x0c, 0x6a, 0xef, 0x0f, 0x46, 0xa9, 0x1a, 0x4f, 0xfb, 0xf1, 0x80, 0x9f, 0x76,
+	0x8b, 0x1d, 0x73, 0x31, 0x2a, 0xed, 0x03, 0x9a, 0xe7, 0xdd, 0xfc, 0xc3, 0xc8, 0xfe, 0x82, 0xfb,
+	0x0d, 0xf3, 0x0f, 0x7e, 0xf8, 0x7b, 0x00, 0x6b, 0xdb, 0xe6, 0x46, 0xa9, 0x05, 0x00, 0x00,
 }
 
 func (m *ShareProof) Marshal() (dAtA []byte, err error) {
@@ -443,7 +685,7 @@ func (m *ShareProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RowProof) Marshal() (dAtA []byte, err error) {
+func (m *MultiShareProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -453,37 +695,20 @@ func (m *RowProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RowProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiShareProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RowProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiShareProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EndRow != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.EndRow))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.StartRow != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.StartRow))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Proofs) > 0 {
-		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RowProofs) > 0 {
+		for iNdEx := len(m.RowProofs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RowProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -491,7 +716,7 @@ func (m *RowProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintProof(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
 	if len(m.RowRoots) > 0 {
@@ -500,13 +725,27 @@ func (m *RowProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			copy(dAtA[i:], m.RowRoots[iNdEx])
 			i = encodeVarintProof(dAtA, i, uint64(len(m.RowRoots[iNdEx])))
 			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ShareRangeProofs) > 0 {
+		for iNdEx := len(m.ShareRangeProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareRangeProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NMTProof) Marshal() (dAtA []byte, err error) {
+func (m *ShareRangeProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -516,46 +755,65 @@ func (m *NMTProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NMTProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *ShareRangeProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NMTProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ShareRangeProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LeafHash) > 0 {
-		i -= len(m.LeafHash)
-		copy(dAtA[i:], m.LeafHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.LeafHash)))
+	if m.EndRow != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.EndRow))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x30
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Nodes[iNdEx])
-			copy(dAtA[i:], m.Nodes[iNdEx])
-			i = encodeVarintProof(dAtA, i, uint64(len(m.Nodes[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.StartRow != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.StartRow))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.End != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.End))
+	if m.NamespaceVersion != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.NamespaceVersion))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if m.Start != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Start))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NamespaceId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if len(m.ShareProofs) > 0 {
+		for iNdEx := len(m.ShareProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Data[iNdEx])
+			copy(dAtA[i:], m.Data[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.Data[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Proof) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceAbsenceProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -565,27 +823,200 @@ func (m *Proof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Proof) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceAbsenceProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceAbsenceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Aunts) > 0 {
-		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Aunts[iNdEx])
-			copy(dAtA[i:], m.Aunts[iNdEx])
-			i = encodeVarintProof(dAtA, i, uint64(len(m.Aunts[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.LeafHash) > 0 {
-		i -= len(m.LeafHash)
+	if len(m.AbsenceProofs) > 0 {
+		for iNdEx := len(m.AbsenceProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AbsenceProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RowProof != nil {
+		{
+			size, err := m.RowProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NamespaceVersion != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.NamespaceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RowProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RowProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RowProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndRow != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.EndRow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartRow != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.StartRow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RowRoots) > 0 {
+		for iNdEx := len(m.RowRoots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RowRoots[iNdEx])
+			copy(dAtA[i:], m.RowRoots[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.RowRoots[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NMTProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NMTProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NMTProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Nodes[iNdEx])
+			copy(dAtA[i:], m.Nodes[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.Nodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.End != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aunts) > 0 {
+		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aunts[iNdEx])
+			copy(dAtA[i:], m.Aunts[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.Aunts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
 		copy(dAtA[i:], m.LeafHash)
 		i = encodeVarintProof(dAtA, i, uint64(len(m.LeafHash)))
 		i--
@@ -647,28 +1078,58 @@ func (m *ShareProof) Size() (n int) {
 	return n
 }
 
-func (m *RowProof) Size() (n int) {
+func (m *MultiShareProof) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.ShareRangeProofs) > 0 {
+		for _, e := range m.ShareRangeProofs {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
 	if len(m.RowRoots) > 0 {
 		for _, b := range m.RowRoots {
 			l = len(b)
 			n += 1 + l + sovProof(uint64(l))
 		}
 	}
-	if len(m.Proofs) > 0 {
-		for _, e := range m.Proofs {
+	if len(m.RowProofs) > 0 {
+		for _, e := range m.RowProofs {
 			l = e.Size()
 			n += 1 + l + sovProof(uint64(l))
 		}
 	}
-	l = len(m.Root)
+	return n
+}
+
+func (m *ShareRangeProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if len(m.ShareProofs) > 0 {
+		for _, e := range m.ShareProofs {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovProof(uint64(l))
 	}
+	if m.NamespaceVersion != 0 {
+		n += 1 + sovProof(uint64(m.NamespaceVersion))
+	}
 	if m.StartRow != 0 {
 		n += 1 + sovProof(uint64(m.StartRow))
 	}
@@ -678,63 +1139,475 @@ func (m *RowProof) Size() (n int) {
 	return n
 }
 
-func (m *NMTProof) Size() (n int) {
+func (m *NamespaceAbsenceProof) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Start != 0 {
-		n += 1 + sovProof(uint64(m.Start))
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
 	}
-	if m.End != 0 {
-		n += 1 + sovProof(uint64(m.End))
+	if m.NamespaceVersion != 0 {
+		n += 1 + sovProof(uint64(m.NamespaceVersion))
 	}
-	if len(m.Nodes) > 0 {
-		for _, b := range m.Nodes {
+	if m.RowProof != nil {
+		l = m.RowProof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if len(m.AbsenceProofs) > 0 {
+		for _, e := range m.AbsenceProofs {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RowProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RowRoots) > 0 {
+		for _, b := range m.RowRoots {
 			l = len(b)
 			n += 1 + l + sovProof(uint64(l))
 		}
 	}
-	l = len(m.LeafHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	return n
-}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.StartRow != 0 {
+		n += 1 + sovProof(uint64(m.StartRow))
+	}
+	if m.EndRow != 0 {
+		n += 1 + sovProof(uint64(m.EndRow))
+	}
+	return n
+}
+
+func (m *NMTProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovProof(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovProof(uint64(m.End))
+	}
+	if len(m.Nodes) > 0 {
+		for _, b := range m.Nodes {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *Proof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovProof(uint64(m.Total))
+	}
+	if m.Index != 0 {
+		n += 1 + sovProof(uint64(m.Index))
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if len(m.Aunts) > 0 {
+		for _, b := range m.Aunts {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProof(x uint64) (n int) {
+	return sovProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ShareProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareProofs = append(m.ShareProofs, &NMTProof{})
+			if err := m.ShareProofs[len(m.ShareProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceId == nil {
+				m.NamespaceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RowProof == nil {
+				m.RowProof = &RowProof{}
+			}
+			if err := m.RowProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
+			}
+			m.NamespaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiShareProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiShareProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiShareProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareRangeProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareRangeProofs = append(m.ShareRangeProofs, &ShareRangeProof{})
+			if err := m.ShareRangeProofs[len(m.ShareRangeProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowRoots", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RowRoots = append(m.RowRoots, make([]byte, postIndex-iNdEx))
+			copy(m.RowRoots[len(m.RowRoots)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RowProofs = append(m.RowProofs, &Proof{})
+			if err := m.RowProofs[len(m.RowProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Proof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Total != 0 {
-		n += 1 + sovProof(uint64(m.Total))
-	}
-	if m.Index != 0 {
-		n += 1 + sovProof(uint64(m.Index))
-	}
-	l = len(m.LeafHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	if len(m.Aunts) > 0 {
-		for _, b := range m.Aunts {
-			l = len(b)
-			n += 1 + l + sovProof(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovProof(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProof(x uint64) (n int) {
-	return sovProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ShareProof) Unmarshal(dAtA []byte) error {
+func (m *ShareRangeProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -757,10 +1630,10 @@ func (m *ShareProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShareProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShareRangeProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShareProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShareRangeProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -864,6 +1737,166 @@ func (m *ShareProof) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
+			}
+			m.NamespaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRow", wireType)
+			}
+			m.StartRow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartRow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndRow", wireType)
+			}
+			m.EndRow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndRow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceAbsenceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceAbsenceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceAbsenceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceId == nil {
+				m.NamespaceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
+			}
+			m.NamespaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RowProof", wireType)
 			}
@@ -899,11 +1932,11 @@ func (m *ShareProof) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsenceProofs", wireType)
 			}
-			m.NamespaceVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -913,11 +1946,26 @@ func (m *ShareProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NamespaceVersion |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbsenceProofs = append(m.AbsenceProofs, &NMTProof{})
+			if err := m.AbsenceProofs[len(m.AbsenceProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package proof
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strconv"
@@ -128,6 +129,68 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuer
 	return rawShareProof, nil
 }
 
+const MultiShareInclusionQueryPath = "multiShareInclusionProof"
+
+// QueryMultiShareInclusionProof defines the logic performed when querying for
+// the inclusion proofs of several share ranges to the data root. The begin and
+// end shares of each range should be appended to the path. Example path for
+// proving the sets of shares [3, 5] and [10, 12]:
+// custom/multiShareInclusionProof/3/5/10/12
+func QueryMultiShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	// parse the share ranges from the path
+	if len(path) == 0 || len(path)%2 != 0 {
+		return nil, fmt.Errorf("expected a non zero even query path length, actual: %d ", len(path))
+	}
+	shareRanges := make([]shares.Range, 0, len(path)/2)
+	for i := 0; i < len(path); i += 2 {
+		beginShare, err := strconv.ParseInt(path[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		endShare, err := strconv.ParseInt(path[i+1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		begin, err := safeConvertInt64ToInt(beginShare)
+		if err != nil {
+			return nil, err
+		}
+		end, err := safeConvertInt64ToInt(endShare)
+		if err != nil {
+			return nil, err
+		}
+		shareRanges = append(shareRanges, shares.NewRange(begin, end))
+	}
+
+	// unmarshal the block data that is passed from the ABCI client
+	pbb := new(tmproto.Block)
+	err := pbb.Unmarshal(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+
+	// construct the data square from the block data. As we don't have
+	// access to the application's state machine we use the upper bound
+	// square size instead of the square size dictated from governance
+	dataSquare, err := square.Construct(pbb.Data.Txs, appconsts.SquareSizeUpperBound(pbb.Header.Version.App), appconsts.SubtreeRootThreshold(pbb.Header.Version.App))
+	if err != nil {
+		return nil, err
+	}
+
+	// create and marshal the multi share proof, which we return in the form of []byte
+	multiShareProof, err := NewMultiShareProof(dataSquare, shareRanges)
+	if err != nil {
+		return nil, err
+	}
+
+	rawMultiShareProof, err := multiShareProof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return rawMultiShareProof, nil
+}
+
 // ParseNamespace validates the share range, checks if it only contains one namespace and returns
 // that namespace ID.
 func ParseNamespace(rawShares []shares.Share, startShare int, endShare int) (appns.Namespace, error) {
@@ -173,3 +236,53 @@ func safeConvertInt64ToInt(x int64) (int, error) {
 	}
 	return int(x), nil
 }
+
+const NamespaceAbsenceQueryPath = "namespaceAbsenceProof"
+
+// QueryNamespaceAbsenceProof defines the logic performed when querying for a
+// proof that a namespace has no shares in a block. The hex encoded namespace
+// should be appended to the path. Example path for proving the absence of a
+// namespace:
+// custom/namespaceAbsenceProof/<hex encoded namespace>
+func QueryNamespaceAbsenceProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	// parse the namespace from the path
+	if len(path) != 1 {
+		return nil, fmt.Errorf("expected query path length: 1 actual: %d ", len(path))
+	}
+	rawNamespace, err := hex.DecodeString(path[0])
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := appns.From(rawNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// unmarshal the block data that is passed from the ABCI client
+	pbb := new(tmproto.Block)
+	err = pbb.Unmarshal(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+
+	// construct the data square from the block data. As we don't have
+	// access to the application's state machine we use the upper bound
+	// square size instead of the square size dictated from governance
+	dataSquare, err := square.Construct(pbb.Data.Txs, appconsts.SquareSizeUpperBound(pbb.Header.Version.App), appconsts.SubtreeRootThreshold(pbb.Header.Version.App))
+	if err != nil {
+		return nil, err
+	}
+
+	// create and marshal the namespace absence proof, which we return in the form of []byte
+	absenceProof, err := NewNamespaceAbsenceProof(dataSquare, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rawAbsenceProof, err := absenceProof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return rawAbsenceProof, nil
+}
@@ -0,0 +1,77 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// rowProver generates NMT range proofs for the shares of the rows of an
+// extended data square.
+type rowProver interface {
+	ProveRange(row uint, start, end int) (nmt.Proof, error)
+}
+
+// newRowProver returns a prover that uses the row trees cached by cacher, or
+// that rebuilds the row trees of the extended data square if cacher is nil.
+func newRowProver(eds *rsmt2d.ExtendedDataSquare, rowRoots [][]byte, cacher *inclusion.EDSSubTreeRootCacher) rowProver {
+	if cacher != nil {
+		return cachedRowProver{cacher: cacher, rowRoots: rowRoots}
+	}
+	return &edsRowProver{eds: eds, rowRoots: rowRoots, trees: make(map[uint]*wrapper.ErasuredNamespacedMerkleTree)}
+}
+
+// edsRowProver rebuilds the trees of the rows of an extended data square to
+// prove their shares. Each tree is built once and shared between proofs.
+type edsRowProver struct {
+	eds      *rsmt2d.ExtendedDataSquare
+	rowRoots [][]byte
+	trees    map[uint]*wrapper.ErasuredNamespacedMerkleTree
+}
+
+func (p *edsRowProver) ProveRange(row uint, start, end int) (nmt.Proof, error) {
+	tree, ok := p.trees[row]
+	if !ok {
+		// we have to re-create the tree as the eds one is not accessible.
+		newTree := wrapper.NewErasuredNamespacedMerkleTree(uint64(p.eds.Width()/2), row)
+		for _, share := range p.eds.Row(row) {
+			if err := newTree.Push(share); err != nil {
+				return nmt.Proof{}, err
+			}
+		}
+		// make sure that the generated root is the same as the eds row root.
+		root, err := newTree.Root()
+		if err != nil {
+			return nmt.Proof{}, err
+		}
+		if !bytes.Equal(p.rowRoots[row], root) {
+			return nmt.Proof{}, errors.New("eds row root is different than tree root")
+		}
+		tree = &newTree
+		p.trees[row] = tree
+	}
+	return tree.ProveRange(start, end)
+}
+
+// cachedRowProver proves the shares of the rows from the trees cached while
+// computing the roots of the extended data square.
+type cachedRowProver struct {
+	cacher   *inclusion.EDSSubTreeRootCacher
+	rowRoots [][]byte
+}
+
+func (p cachedRowProver) ProveRange(row uint, start, end int) (nmt.Proof, error) {
+	// make sure that the cached root is the same as the eds row root.
+	root, err := p.cacher.RowRoot(row)
+	if err != nil {
+		return nmt.Proof{}, err
+	}
+	if !bytes.Equal(p.rowRoots[row], root) {
+		return nmt.Proof{}, errors.New("eds row root is different than cached row root")
+	}
+	return p.cacher.ProveRange(row, start, end)
+}
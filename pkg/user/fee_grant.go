@@ -0,0 +1,252 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// feeGrantGasOverhead is added to the default gas estimation of a PFB whose
+// fee is paid by a fee grant. It covers reading and updating the grant in the
+// x/feegrant module which types.DefaultEstimateGas does not account for.
+const feeGrantGasOverhead = 25_000
+
+// WithFeeGrants is a functional option that makes the client pay the fees of
+// its transactions using x/feegrant allowances. If a transaction doesn't set a
+// fee granter, the client picks the first granter whose allowance covers the
+// fee and the messages of the transaction. If it does, the allowance of that
+// granter is checked. In both cases the transaction is rejected before being
+// broadcast if no allowance can pay for it.
+func WithFeeGrants() Option {
+	return func(c *TxClient) {
+		c.useFeeGrants = true
+	}
+}
+
+// FeeGrant is a fee allowance granted by a granter to a grantee.
+type FeeGrant struct {
+	Granter   sdktypes.AccAddress
+	Grantee   sdktypes.AccAddress
+	Allowance feegrant.FeeAllowanceI
+}
+
+// SpendLimit returns the amount that can still be spent using the grant at
+// the provided time, taking the current period of periodic allowances into
+// account. It returns false if the grant has no spend limit.
+func (g FeeGrant) SpendLimit(now time.Time) (sdktypes.Coins, bool) {
+	allowance, _ := g.innerAllowance()
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		return a.SpendLimit, a.SpendLimit != nil
+	case *feegrant.PeriodicAllowance:
+		canSpend := a.PeriodCanSpend
+		if !now.Before(a.PeriodReset) {
+			// the period will be reset by the next transaction
+			canSpend = a.PeriodSpendLimit
+			if a.Basic.SpendLimit != nil {
+				canSpend = canSpend.Min(a.Basic.SpendLimit)
+			}
+		}
+		return canSpend, true
+	default:
+		return nil, false
+	}
+}
+
+// Expiration returns when the grant expires or nil if it doesn't expire.
+func (g FeeGrant) Expiration() *time.Time {
+	allowance, _ := g.innerAllowance()
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		return a.Expiration
+	case *feegrant.PeriodicAllowance:
+		return a.Basic.Expiration
+	default:
+		return nil
+	}
+}
+
+// AllowedMessages returns the type URLs of the messages the grant can pay the
+// fees of. It returns nil if any message is allowed.
+func (g FeeGrant) AllowedMessages() []string {
+	if a, ok := g.Allowance.(*feegrant.AllowedMsgAllowance); ok {
+		return a.AllowedMessages
+	}
+	return nil
+}
+
+// Accepts returns an error explaining why the grant can't pay the fee of a
+// transaction with the provided messages at the provided time. It runs the
+// same checks as the x/feegrant module.
+func (g FeeGrant) Accepts(now time.Time, fee sdktypes.Coins, msgs []sdktypes.Msg) error {
+	// the allowance is copied as accepting a fee updates its state
+	allowance, err := copyAllowance(g.Allowance)
+	if err != nil {
+		return err
+	}
+	ctx := sdktypes.Context{}.WithBlockTime(now).WithGasMeter(sdktypes.NewInfiniteGasMeter())
+	if _, err := allowance.Accept(ctx, fee, msgs); err != nil {
+		return fmt.Errorf("fee grant from %s to %s: %w", g.Granter, g.Grantee, err)
+	}
+	return nil
+}
+
+func (g FeeGrant) innerAllowance() (feegrant.FeeAllowanceI, error) {
+	if a, ok := g.Allowance.(*feegrant.AllowedMsgAllowance); ok {
+		return a.GetAllowance()
+	}
+	return g.Allowance, nil
+}
+
+func copyAllowance(allowance feegrant.FeeAllowanceI) (feegrant.FeeAllowanceI, error) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		c := *a
+		return &c, nil
+	case *feegrant.PeriodicAllowance:
+		c := *a
+		return &c, nil
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		inner, err = copyAllowance(inner)
+		if err != nil {
+			return nil, err
+		}
+		return feegrant.NewAllowedMsgAllowance(inner, a.AllowedMessages)
+	default:
+		return nil, fmt.Errorf("unsupported fee allowance %T", allowance)
+	}
+}
+
+// FeeGrants returns the fee grants of which the account is the grantee.
+func (client *TxClient) FeeGrants(ctx context.Context, account string) ([]FeeGrant, error) {
+	record, err := client.signer.keys.Key(account)
+	if err != nil {
+		return nil, fmt.Errorf("trying to find account %s on keyring: %w", account, err)
+	}
+	grantee, err := record.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving address from keyring: %w", err)
+	}
+	return client.queryFeeGrants(ctx, grantee)
+}
+
+// FeeGrant returns the fee grant from the granter to the grantee.
+func (client *TxClient) FeeGrant(ctx context.Context, granter, grantee sdktypes.AccAddress) (FeeGrant, error) {
+	resp, err := feegrant.NewQueryClient(client.grpc).Allowance(ctx, &feegrant.QueryAllowanceRequest{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+	})
+	if err != nil {
+		return FeeGrant{}, fmt.Errorf("querying fee grant from %s to %s: %w", granter, grantee, err)
+	}
+	return client.newFeeGrant(resp.Allowance)
+}
+
+func (client *TxClient) queryFeeGrants(ctx context.Context, grantee sdktypes.AccAddress) ([]FeeGrant, error) {
+	queryClient := feegrant.NewQueryClient(client.grpc)
+	var (
+		grants []FeeGrant
+		key    []byte
+	)
+	for {
+		resp, err := queryClient.Allowances(ctx, &feegrant.QueryAllowancesRequest{
+			Grantee:    grantee.String(),
+			Pagination: &query.PageRequest{Key: key},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("querying fee grants of %s: %w", grantee, err)
+		}
+		for _, grant := range resp.Allowances {
+			feeGrant, err := client.newFeeGrant(grant)
+			if err != nil {
+				return nil, err
+			}
+			grants = append(grants, feeGrant)
+		}
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			return grants, nil
+		}
+		key = resp.Pagination.NextKey
+	}
+}
+
+func (client *TxClient) newFeeGrant(grant *feegrant.Grant) (FeeGrant, error) {
+	granter, err := sdktypes.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return FeeGrant{}, err
+	}
+	grantee, err := sdktypes.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return FeeGrant{}, err
+	}
+	var allowance feegrant.FeeAllowanceI
+	if err := client.registry.UnpackAny(grant.Allowance, &allowance); err != nil {
+		return FeeGrant{}, fmt.Errorf("decoding fee allowance from %s: %w", granter, err)
+	}
+	return FeeGrant{Granter: granter, Grantee: grantee, Allowance: allowance}, nil
+}
+
+// applyFeeGrant checks that a fee grant can pay for the transaction. If the
+// transaction has a fee granter, its grant is checked. Otherwise the first
+// grant of the grantee that can pay for the transaction is used.
+func (client *TxClient) applyFeeGrant(ctx context.Context, grantee sdktypes.AccAddress, builder client.TxBuilder) error {
+	tx := builder.GetTx()
+	now, err := client.latestBlockTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	if granter := tx.FeeGranter(); granter != nil {
+		grant, err := client.FeeGrant(ctx, granter, grantee)
+		if err != nil {
+			return err
+		}
+		return grant.Accepts(now, tx.GetFee(), tx.GetMsgs())
+	}
+
+	grants, err := client.queryFeeGrants(ctx, grantee)
+	if err != nil {
+		return err
+	}
+	if len(grants) == 0 {
+		return fmt.Errorf("no fee grants found for %s", grantee)
+	}
+	reasons := make([]string, 0, len(grants))
+	for _, grant := range grants {
+		if err := grant.Accepts(now, tx.GetFee(), tx.GetMsgs()); err != nil {
+			reasons = append(reasons, err.Error())
+			continue
+		}
+		builder.SetFeeGranter(grant.Granter)
+		return nil
+	}
+	return errors.New("no fee grant can pay for the transaction: " + strings.Join(reasons, "; "))
+}
+
+func (client *TxClient) latestBlockTime(ctx context.Context) (time.Time, error) {
+	resp, err := tmservice.NewServiceClient(client.grpc).GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("querying latest block: %w", err)
+	}
+	// older nodes only fill the deprecated block field
+	switch {
+	case resp.SdkBlock != nil:
+		return resp.SdkBlock.Header.Time, nil
+	case resp.Block != nil:
+		return resp.Block.Header.Time, nil
+	default:
+		return time.Time{}, errors.New("the latest block is missing from the response")
+	}
+}
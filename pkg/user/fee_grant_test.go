@@ -0,0 +1,68 @@
+package user_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/pkg/user"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+)
+
+func TestFeeGrant(t *testing.T) {
+	now := time.Now()
+	expiration := now.Add(time.Hour)
+	granter := testnode.RandomAddress().(sdk.AccAddress)
+	grantee := testnode.RandomAddress().(sdk.AccAddress)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, amount)) }
+	pfb := []sdk.Msg{&blobtypes.MsgPayForBlobs{Signer: grantee.String()}}
+	send := []sdk.Msg{bank.NewMsgSend(grantee, granter, coins(1))}
+
+	basic := &feegrant.BasicAllowance{SpendLimit: coins(100), Expiration: &expiration}
+	allowed, err := feegrant.NewAllowedMsgAllowance(basic, []string{sdk.MsgTypeURL(pfb[0])})
+	require.NoError(t, err)
+	grant := user.FeeGrant{Granter: granter, Grantee: grantee, Allowance: allowed}
+
+	spendLimit, limited := grant.SpendLimit(now)
+	require.True(t, limited)
+	require.Equal(t, coins(100), spendLimit)
+	require.Equal(t, &expiration, grant.Expiration())
+	require.Equal(t, []string{"/celestia.blob.v1.MsgPayForBlobs"}, grant.AllowedMessages())
+
+	require.NoError(t, grant.Accepts(now, coins(100), pfb))
+	require.Error(t, grant.Accepts(now, coins(101), pfb), "spend limit exceeded")
+	require.Error(t, grant.Accepts(now, coins(1), send), "message not allowed")
+	require.Error(t, grant.Accepts(expiration.Add(time.Second), coins(1), pfb), "grant expired")
+
+	// accepting a fee doesn't update the grant
+	spendLimit, _ = grant.SpendLimit(now)
+	require.Equal(t, coins(100), spendLimit)
+
+	periodic := user.FeeGrant{Granter: granter, Grantee: grantee, Allowance: &feegrant.PeriodicAllowance{
+		Basic:            feegrant.BasicAllowance{SpendLimit: coins(50)},
+		Period:           time.Hour,
+		PeriodSpendLimit: coins(20),
+		PeriodCanSpend:   coins(5),
+		PeriodReset:      expiration,
+	}}
+	spendLimit, _ = periodic.SpendLimit(now)
+	require.Equal(t, coins(5), spendLimit)
+	spendLimit, _ = periodic.SpendLimit(expiration)
+	require.Equal(t, coins(20), spendLimit)
+	require.Nil(t, periodic.Expiration())
+	require.Nil(t, periodic.AllowedMessages())
+	require.NoError(t, periodic.Accepts(now, coins(5), send))
+	require.Error(t, periodic.Accepts(now, coins(6), send))
+	require.NoError(t, periodic.Accepts(expiration, coins(20), send))
+
+	unlimited := user.FeeGrant{Granter: granter, Grantee: grantee, Allowance: &feegrant.BasicAllowance{}}
+	_, limited = unlimited.SpendLimit(now)
+	require.False(t, limited)
+	require.NoError(t, unlimited.Accepts(now, coins(1e9), send))
+}
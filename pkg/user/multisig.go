@@ -0,0 +1,176 @@
+package user
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// multisigSignMode is the sign mode used by the members of a multisig. Direct
+// sign bytes include the signer infos of the multisig which can't be known
+// before all members have signed.
+const multisigSignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// secp256k1SignatureSize is the size of the placeholder signatures used to
+// estimate the gas of multisig transactions.
+const secp256k1SignatureSize = 64
+
+// ExternalSigner signs on behalf of a member of a multisig account whose key
+// is not stored in the keyring, for example a hardware wallet, a remote
+// signing service or a key held by another party.
+type ExternalSigner interface {
+	// PubKey returns the public key of the member.
+	PubKey() cryptotypes.PubKey
+	// Sign returns the signature over the SIGN_MODE_LEGACY_AMINO_JSON sign
+	// bytes of a transaction.
+	Sign(signBytes []byte) ([]byte, error)
+}
+
+// AddExternalSigner registers an external signer that is used to sign
+// transactions of the multisig accounts it is a member of.
+func (s *Signer) AddExternalSigner(signer ExternalSigner) {
+	s.externalSigners[sdktypes.AccAddress(signer.PubKey().Address()).String()] = signer
+}
+
+// IsMultisig returns true if the account is a legacy amino multisig account.
+func (a Account) IsMultisig() bool {
+	_, ok := a.pubKey.(*kmultisig.LegacyAminoPubKey)
+	return ok
+}
+
+// signMultisigTransaction collects the signatures of the members of the
+// multisig account until the threshold is reached and sets the resulting
+// multisig signature on the transaction.
+func (s *Signer) signMultisigTransaction(builder client.TxBuilder, account *Account, pubKey *kmultisig.LegacyAminoPubKey) error {
+	signerData := authsigning.SignerData{
+		Address:       account.address.String(),
+		ChainID:       s.ChainID(),
+		AccountNumber: account.accountNumber,
+		Sequence:      account.sequence,
+		PubKey:        pubKey,
+	}
+	bytesToSign, err := s.enc.SignModeHandler().GetSignBytes(multisigSignMode, signerData, builder.GetTx())
+	if err != nil {
+		return fmt.Errorf("error getting sign bytes: %w", err)
+	}
+
+	multisigSig, err := s.collectMultisigSignatures(pubKey, bytesToSign)
+	if err != nil {
+		return fmt.Errorf("signing with multisig account %s: %w", account.name, err)
+	}
+
+	err = builder.SetSignatures(s.getMultisigSignatureV2(account.sequence, pubKey, multisigSig))
+	if err != nil {
+		return fmt.Errorf("error setting signatures: %w", err)
+	}
+	return nil
+}
+
+// collectMultisigSignatures signs the bytes with the keys of the members of
+// the multisig that are either in the keyring or registered as external
+// signers, until the threshold of the multisig is reached.
+func (s *Signer) collectMultisigSignatures(pubKey *kmultisig.LegacyAminoPubKey, bytesToSign []byte) (*signing.MultiSignatureData, error) {
+	multisigSig := multisig.NewMultisig(len(pubKey.PubKeys))
+	collected := 0
+	for _, member := range pubKey.GetPubKeys() {
+		if collected == int(pubKey.Threshold) {
+			break
+		}
+
+		signature, found, err := s.signAsMember(member, bytesToSign)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		data := &signing.SingleSignatureData{SignMode: multisigSignMode, Signature: signature}
+		if err := multisig.AddSignatureFromPubKey(multisigSig, data, member, pubKey.GetPubKeys()); err != nil {
+			return nil, err
+		}
+		collected++
+	}
+	if collected < int(pubKey.Threshold) {
+		return nil, fmt.Errorf("collected %d signatures, the multisig threshold is %d", collected, pubKey.Threshold)
+	}
+	return multisigSig, nil
+}
+
+// signAsMember signs the bytes with the key of the member if it is in the
+// keyring or registered as an external signer. It returns false if the
+// signer can't sign on behalf of the member.
+func (s *Signer) signAsMember(member cryptotypes.PubKey, bytesToSign []byte) ([]byte, bool, error) {
+	address := sdktypes.AccAddress(member.Address())
+	if signer, exists := s.externalSigners[address.String()]; exists {
+		signature, err := signer.Sign(bytesToSign)
+		if err != nil {
+			return nil, false, fmt.Errorf("external signer %s: %w", address, err)
+		}
+		return signature, true, nil
+	}
+
+	record, err := s.keys.KeyByAddress(address)
+	if err != nil || record.GetLocal() == nil {
+		// only keys with a private key in the keyring can sign
+		return nil, false, nil
+	}
+	signature, _, err := s.keys.SignByAddress(address, bytesToSign)
+	if err != nil {
+		return nil, false, fmt.Errorf("error signing bytes with member %s: %w", record.Name, err)
+	}
+	return signature, true, nil
+}
+
+// signUnsignedBlobTxWithMultisig collects the signatures of the members of the
+// multisig signer of the unsigned blob tx and sets the multisig signature on it.
+func (s *Signer) signUnsignedBlobTxWithMultisig(utx *UnsignedBlobTx, pubKey *kmultisig.LegacyAminoPubKey) (signing.SignatureV2, error) {
+	bytesToSign, err := utx.SignBytes(s.enc, multisigSignMode, pubKey)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	multisigSig, err := s.collectMultisigSignatures(pubKey, bytesToSign)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	sig := utx.signatureV2(pubKey, multisigSig)
+	if err := utx.SetSignatures(s.enc, sig); err != nil {
+		return signing.SignatureV2{}, err
+	}
+	return sig, nil
+}
+
+// draftMultisigSignature returns a multisig signature with placeholder
+// signatures from the first threshold members. It has the size of a real
+// signature and is used to estimate the gas of a transaction without asking
+// the members to sign.
+func draftMultisigSignature(pubKey *kmultisig.LegacyAminoPubKey) (*signing.MultiSignatureData, error) {
+	multisigSig := multisig.NewMultisig(len(pubKey.PubKeys))
+	for _, member := range pubKey.GetPubKeys()[:pubKey.Threshold] {
+		data := &signing.SingleSignatureData{
+			SignMode:  multisigSignMode,
+			Signature: make([]byte, secp256k1SignatureSize),
+		}
+		if err := multisig.AddSignatureFromPubKey(multisigSig, data, member, pubKey.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+	return multisigSig, nil
+}
+
+func (s *Signer) getMultisigSignatureV2(sequence uint64, pubKey cryptotypes.PubKey, data *signing.MultiSignatureData) signing.SignatureV2 {
+	sigV2 := signing.SignatureV2{
+		Data:     data,
+		Sequence: sequence,
+	}
+	if sequence == 0 {
+		sigV2.PubKey = pubKey
+	}
+	return sigV2
+}
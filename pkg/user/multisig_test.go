@@ -0,0 +1,131 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/go-square/blob"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/user"
+	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+)
+
+// keyringSigner is an external signer backed by a keyring that is not the one
+// of the signer.
+type keyringSigner struct {
+	keys keyring.Keyring
+	name string
+}
+
+func (s keyringSigner) PubKey() cryptotypes.PubKey {
+	record, err := s.keys.Key(s.name)
+	if err != nil {
+		panic(err)
+	}
+	pk, err := record.GetPubKey()
+	if err != nil {
+		panic(err)
+	}
+	return pk
+}
+
+func (s keyringSigner) Sign(signBytes []byte) ([]byte, error) {
+	signature, _, err := s.keys.Sign(s.name, signBytes)
+	return signature, err
+}
+
+func TestSignerMultisig(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr, _ := testnode.NewKeyring("member1", "member2")
+	externalKr, _ := testnode.NewKeyring("member3")
+	external := keyringSigner{keys: externalKr, name: "member3"}
+
+	pubKeys := []cryptotypes.PubKey{}
+	for _, name := range []string{"member1", "member2"} {
+		record, err := kr.Key(name)
+		require.NoError(t, err)
+		pk, err := record.GetPubKey()
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pk)
+	}
+	pubKeys = append(pubKeys, external.PubKey())
+
+	newMultisigSigner := func(t *testing.T, threshold int) *user.Signer {
+		multisigPub := kmultisig.NewLegacyAminoPubKey(threshold, pubKeys)
+		name := rand.Str(6)
+		_, err := kr.SaveMultisig(name, multisigPub)
+		require.NoError(t, err)
+		signer, err := user.NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, appconsts.LatestVersion, user.NewAccount(name, 1, 0))
+		require.NoError(t, err)
+		require.True(t, signer.Account(name).IsMultisig())
+		return signer
+	}
+
+	verify := func(t *testing.T, signer *user.Signer, txBytes []byte) {
+		tx, err := signer.DecodeTx(txBytes)
+		require.NoError(t, err)
+		sigs, err := tx.GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+
+		account := signer.Accounts()[0]
+		signerData := authsigning.SignerData{
+			Address:       account.Address().String(),
+			ChainID:       testfactory.ChainID,
+			AccountNumber: 1,
+			Sequence:      0,
+		}
+		err = authsigning.VerifySignature(account.PubKey(), signerData, sigs[0].Data, encCfg.TxConfig.SignModeHandler(), tx)
+		require.NoError(t, err)
+	}
+
+	t.Run("local keys reach the threshold", func(t *testing.T) {
+		signer := newMultisigSigner(t, 2)
+		account := signer.Accounts()[0]
+		msg := bank.NewMsgSend(account.Address(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+		txBytes, err := signer.CreateTx([]sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e4))
+		require.NoError(t, err)
+		verify(t, signer, txBytes)
+	})
+
+	t.Run("external signer is needed to reach the threshold", func(t *testing.T) {
+		signer := newMultisigSigner(t, 3)
+		account := signer.Accounts()[0]
+		blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 100)
+
+		_, _, err := signer.CreatePayForBlobs(account.Name(), blobs, user.SetGasLimit(1e6), user.SetFee(1e4))
+		require.Error(t, err)
+
+		signer.AddExternalSigner(external)
+		blobTxBytes, _, err := signer.CreatePayForBlobs(account.Name(), blobs, user.SetGasLimit(1e6), user.SetFee(1e4))
+		require.NoError(t, err)
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(blobTxBytes)
+		require.True(t, isBlobTx)
+		verify(t, signer, blobTx.Tx)
+	})
+
+	t.Run("unsigned blob tx", func(t *testing.T) {
+		signer := newMultisigSigner(t, 2)
+		account := signer.Accounts()[0]
+		utx, err := signer.CreateUnsignedPayForBlobs(account.Name(), blobfactory.ManyRandBlobs(rand.NewRand(), 100))
+		require.NoError(t, err)
+		_, err = signer.SignUnsignedBlobTx(account.Name(), utx, signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		signed, err := utx.IsSigned(encCfg.TxConfig)
+		require.NoError(t, err)
+		require.True(t, signed)
+	})
+}
@@ -0,0 +1,446 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/celestiaorg/go-square/blob"
+	"github.com/celestiaorg/go-square/inclusion"
+	"github.com/celestiaorg/go-square/merkle"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+)
+
+// PayloadOption configures how a payload is split into blobs and submitted.
+type PayloadOption func(cfg *payloadConfig)
+
+type payloadConfig struct {
+	account     string
+	maxBlobSize int
+	txOpts      []TxOption
+}
+
+// WithPayloadAccount sets the account that signs and pays for the PFBs of a
+// payload. By default, the default account of the TxClient is used.
+func WithPayloadAccount(name string) PayloadOption {
+	return func(cfg *payloadConfig) {
+		cfg.account = name
+	}
+}
+
+// WithPayloadMaxBlobSize caps the number of bytes of each blob a payload is
+// split into. By default, the largest blob that fits in a data square of the
+// governance max square size is used.
+func WithPayloadMaxBlobSize(size int) PayloadOption {
+	return func(cfg *payloadConfig) {
+		cfg.maxBlobSize = size
+	}
+}
+
+// WithPayloadTxOptions sets the TxOptions used for every PFB of a payload.
+func WithPayloadTxOptions(opts ...TxOption) PayloadOption {
+	return func(cfg *payloadConfig) {
+		cfg.txOpts = opts
+	}
+}
+
+// PayloadManifest lists the blobs a payload was split into. The payload is
+// reassembled by concatenating the data of the blobs in the order of the
+// entries. The manifest is signed by the account that paid for the blobs.
+type PayloadManifest struct {
+	// Signer is the bech32 address of the account that paid for the blobs.
+	Signer string `json:"signer"`
+	// PubKey is the compressed secp256k1 public key of the signer.
+	PubKey []byte `json:"pub_key"`
+	// Size is the size of the payload in bytes.
+	Size uint64 `json:"size"`
+	// SHA256 is the hash of the payload.
+	SHA256    []byte         `json:"sha256"`
+	Entries   []PayloadEntry `json:"entries"`
+	Signature []byte         `json:"signature,omitempty"`
+}
+
+// PayloadEntry locates a single blob of a payload.
+type PayloadEntry struct {
+	Height     int64  `json:"height"`
+	Namespace  []byte `json:"namespace"`
+	Commitment []byte `json:"commitment"`
+	Size       uint32 `json:"size"`
+}
+
+// SignBytes returns the bytes of the manifest that are signed.
+func (m PayloadManifest) SignBytes() ([]byte, error) {
+	m.Signature = nil
+	return json.Marshal(m)
+}
+
+// Verify returns an error if the manifest was not signed by its signer.
+func (m PayloadManifest) Verify() error {
+	if len(m.Signature) == 0 {
+		return errors.New("payload manifest is not signed")
+	}
+	if len(m.PubKey) != secp256k1.PubKeySize {
+		return fmt.Errorf("invalid public key size %d", len(m.PubKey))
+	}
+	pubKey := &secp256k1.PubKey{Key: m.PubKey}
+	_, addr, err := bech32.DecodeAndConvert(m.Signer)
+	if err != nil {
+		return fmt.Errorf("decoding signer address: %w", err)
+	}
+	if !bytes.Equal(addr, pubKey.Address()) {
+		return fmt.Errorf("public key does not match signer %s", m.Signer)
+	}
+	signBytes, err := m.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, m.Signature) {
+		return errors.New("invalid payload manifest signature")
+	}
+	return nil
+}
+
+// pfbEvictionBlocks is the number of blocks after which a PFB of a payload
+// that is still not committed is considered evicted from the mempool. It is
+// twice the mempool TTL of the default node config.
+const pfbEvictionBlocks = 10
+
+// pendingPFB is a PFB of a payload that was broadcast but not yet confirmed.
+type pendingPFB struct {
+	txHash string
+	// height is the latest height of the chain when the PFB was broadcast.
+	height      int64
+	namespace   []byte
+	sizes       []uint32
+	commitments [][]byte
+}
+
+// SubmitPayload splits the payload read from r into as many blobs as needed,
+// submits them to the namespace across as many PFBs as needed and waits for
+// all of them to be committed. The PFBs may be included in different blocks.
+// It returns a manifest, signed by the paying account, that can be used to
+// fetch and reassemble the payload with FetchPayload. It returns an error if
+// a PFB is evicted from the mempool before being committed.
+func (client *TxClient) SubmitPayload(ctx context.Context, namespace appns.Namespace, r io.Reader, opts ...PayloadOption) (*PayloadManifest, error) {
+	cfg := payloadConfig{account: client.defaultAccount}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	client.mtx.Lock()
+	err := client.checkAccountLoaded(ctx, cfg.account)
+	client.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	squareSize, err := client.maxSquareSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(client.signer.appVersion)
+	largestBlobSize := blobtypes.LargestBlobSize(squareSize, subtreeRootThreshold)
+	maxBlobSize := cfg.maxBlobSize
+	switch {
+	case maxBlobSize == 0:
+		maxBlobSize = largestBlobSize
+	case maxBlobSize < 0 || maxBlobSize > largestBlobSize:
+		return nil, fmt.Errorf("max blob size %d must be between 1 and %d", maxBlobSize, largestBlobSize)
+	}
+
+	var (
+		hasher  = sha256.New()
+		tee     = io.TeeReader(r, hasher)
+		size    uint64
+		group   []*blob.Blob
+		sizes   []int
+		pending []pendingPFB
+	)
+	broadcast := func() error {
+		if len(group) == 0 {
+			return nil
+		}
+		commitments, err := inclusion.CreateCommitments(group, merkle.HashFromByteSlices, subtreeRootThreshold)
+		if err != nil {
+			return err
+		}
+		height, err := client.latestHeight(ctx)
+		if err != nil {
+			return err
+		}
+		resp, err := client.BroadcastPayForBlobWithAccount(ctx, cfg.account, group, cfg.txOpts...)
+		if err != nil {
+			return err
+		}
+		pfb := pendingPFB{txHash: resp.TxHash, height: height, namespace: namespace.Bytes(), commitments: commitments}
+		for _, b := range group {
+			pfb.sizes = append(pfb.sizes, uint32(len(b.Data)))
+		}
+		pending = append(pending, pfb)
+		group, sizes = nil, nil
+		return nil
+	}
+
+	for {
+		chunk := make([]byte, maxBlobSize)
+		n, err := io.ReadFull(tee, chunk)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("reading payload: %w", err)
+		}
+		b, err := blobtypes.NewBlob(namespace, chunk[:n], appconsts.ShareVersionZero)
+		if err != nil {
+			return nil, err
+		}
+		if !blobtypes.BlobsFitInSquare(squareSize, subtreeRootThreshold, append(sizes, n)...) {
+			if err := broadcast(); err != nil {
+				return nil, err
+			}
+		}
+		group = append(group, b)
+		sizes = append(sizes, n)
+		size += uint64(n)
+		if n < maxBlobSize {
+			break
+		}
+	}
+	if size == 0 {
+		return nil, errors.New("payload is empty")
+	}
+	if err := broadcast(); err != nil {
+		return nil, err
+	}
+
+	manifest := &PayloadManifest{
+		Size:   size,
+		SHA256: hasher.Sum(nil),
+	}
+	for _, pfb := range pending {
+		resp, err := client.confirmPFB(ctx, pfb)
+		if err != nil {
+			return nil, fmt.Errorf("confirming PFB %s: %w", pfb.txHash, err)
+		}
+		for i, commitment := range pfb.commitments {
+			manifest.Entries = append(manifest.Entries, PayloadEntry{
+				Height:     resp.Height,
+				Namespace:  pfb.namespace,
+				Commitment: commitment,
+				Size:       pfb.sizes[i],
+			})
+		}
+	}
+
+	client.mtx.Lock()
+	err = client.signer.SignPayloadManifest(cfg.account, manifest)
+	client.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// confirmPFB waits for the PFB of a payload to be committed like ConfirmTx,
+// but stops waiting once the PFB is still not found pfbEvictionBlocks blocks
+// after it was broadcast, as it is then gone from the mempool.
+func (client *TxClient) confirmPFB(ctx context.Context, pfb pendingPFB) (*sdktypes.TxResponse, error) {
+	txClient := sdktx.NewServiceClient(client.grpc)
+
+	pollTicker := time.NewTicker(client.pollTime)
+	defer pollTicker.Stop()
+
+	for {
+		resp, err := txClient.GetTx(ctx, &sdktx.GetTxRequest{Hash: pfb.txHash})
+		if err == nil {
+			if resp.TxResponse.Code != 0 {
+				return resp.TxResponse, fmt.Errorf("tx was included but failed with code %d: %s", resp.TxResponse.Code, resp.TxResponse.RawLog)
+			}
+			return resp.TxResponse, nil
+		}
+		if !strings.Contains(err.Error(), "not found") {
+			return nil, err
+		}
+		height, err := client.latestHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if height > pfb.height+pfbEvictionBlocks {
+			return nil, fmt.Errorf("not committed %d blocks after it was broadcast at height %d: the PFB was evicted from the mempool", height-pfb.height, pfb.height)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-pollTicker.C:
+		}
+	}
+}
+
+// latestHeight returns the latest height of the chain. It queries the latest
+// validator set rather than the latest block to avoid fetching its data.
+func (client *TxClient) latestHeight(ctx context.Context) (int64, error) {
+	resp, err := tmservice.NewServiceClient(client.grpc).GetLatestValidatorSet(ctx, &tmservice.GetLatestValidatorSetRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("querying the latest height: %w", err)
+	}
+	return resp.BlockHeight, nil
+}
+
+// maxSquareSize returns the max effective square size of the network based on
+// the governance parameter and the versioned constant.
+func (client *TxClient) maxSquareSize(ctx context.Context) (int, error) {
+	resp, err := blobtypes.NewQueryClient(client.grpc).Params(ctx, &blobtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("querying blob params: %w", err)
+	}
+	upperBound := appconsts.SquareSizeUpperBound(client.signer.appVersion)
+	return min(upperBound, int(resp.Params.GovMaxSquareSize)), nil
+}
+
+// SignPayloadManifest signs the manifest with the key of the provided account.
+// Only secp256k1 keys are supported.
+func (s *Signer) SignPayloadManifest(accountName string, manifest *PayloadManifest) error {
+	acc, exists := s.accounts[accountName]
+	if !exists {
+		return fmt.Errorf("account %s not found", accountName)
+	}
+	pubKey, ok := acc.pubKey.(*secp256k1.PubKey)
+	if !ok {
+		return fmt.Errorf("unsupported public key type %T for signing a payload manifest", acc.pubKey)
+	}
+	manifest.Signer = acc.address.String()
+	manifest.PubKey = pubKey.Bytes()
+	signBytes, err := manifest.SignBytes()
+	if err != nil {
+		return err
+	}
+	signature, _, err := s.keys.Sign(accountName, signBytes)
+	if err != nil {
+		return fmt.Errorf("error signing payload manifest: %w", err)
+	}
+	manifest.Signature = signature
+	return nil
+}
+
+// FetchPayload verifies the signature of the manifest, fetches the blobs it
+// lists from the blocks they were included in and writes the reassembled
+// payload to w. Every block is verified by recomputing its data root from the
+// block data and every blob is verified against its share commitment.
+func FetchPayload(ctx context.Context, conn *grpc.ClientConn, manifest *PayloadManifest, w io.Writer) error {
+	if err := manifest.Verify(); err != nil {
+		return err
+	}
+
+	var (
+		hasher = sha256.New()
+		out    = io.MultiWriter(w, hasher)
+		size   uint64
+		height int64
+		blobs  []*blob.Blob
+		// subtreeRootThreshold is the threshold of the app version of the
+		// block that was fetched last
+		subtreeRootThreshold int
+	)
+	for i, entry := range manifest.Entries {
+		if entry.Height != height {
+			var appVersion uint64
+			var err error
+			blobs, appVersion, err = fetchVerifiedBlobs(ctx, conn, entry.Height)
+			if err != nil {
+				return err
+			}
+			height = entry.Height
+			subtreeRootThreshold = appconsts.SubtreeRootThreshold(appVersion)
+		}
+
+		data, err := findBlob(blobs, entry, subtreeRootThreshold)
+		if err != nil {
+			return fmt.Errorf("entry %d at height %d: %w", i, entry.Height, err)
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+		size += uint64(len(data))
+	}
+
+	if size != manifest.Size {
+		return fmt.Errorf("reassembled payload size %d does not match manifest size %d", size, manifest.Size)
+	}
+	if !bytes.Equal(hasher.Sum(nil), manifest.SHA256) {
+		return errors.New("reassembled payload hash does not match manifest hash")
+	}
+	return nil
+}
+
+// fetchVerifiedBlobs returns the blobs of the block at the provided height
+// along with the app version of the block. The block data is verified against
+// the data root in the block header.
+func fetchVerifiedBlobs(ctx context.Context, conn *grpc.ClientConn, height int64) ([]*blob.Blob, uint64, error) {
+	resp, err := tmservice.NewServiceClient(conn).GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: height})
+	if err != nil {
+		return nil, 0, fmt.Errorf("fetching block %d: %w", height, err)
+	}
+	header, txs := resp.SdkBlock.Header, resp.SdkBlock.Data.Txs
+	appVersion := header.Version.App
+
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return nil, 0, fmt.Errorf("constructing square of block %d: %w", height, err)
+	}
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return nil, 0, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !bytes.Equal(dah.Hash(), header.DataHash) {
+		return nil, 0, fmt.Errorf("data root of block %d does not match its header", height)
+	}
+
+	var blobs []*blob.Blob
+	for _, tx := range txs {
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+		if isBlobTx {
+			blobs = append(blobs, blobTx.Blobs...)
+		}
+	}
+	return blobs, appVersion, nil
+}
+
+// findBlob returns the data of the blob matching the entry.
+func findBlob(blobs []*blob.Blob, entry PayloadEntry, subtreeRootThreshold int) ([]byte, error) {
+	for _, b := range blobs {
+		if len(b.Data) != int(entry.Size) || !bytes.Equal(b.Namespace().Bytes(), entry.Namespace) {
+			continue
+		}
+		commitment, err := inclusion.CreateCommitment(b, merkle.HashFromByteSlices, subtreeRootThreshold)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(commitment, entry.Commitment) {
+			return b.Data, nil
+		}
+	}
+	return nil, errors.New("blob not found")
+}
@@ -0,0 +1,39 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/user"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+)
+
+func TestPayloadManifestSignature(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+
+	manifest := &user.PayloadManifest{
+		Size:   10,
+		SHA256: []byte("hash"),
+		Entries: []user.PayloadEntry{
+			{Height: 5, Namespace: []byte("namespace"), Commitment: []byte("commitment"), Size: 10},
+		},
+	}
+	require.Error(t, manifest.Verify())
+
+	require.NoError(t, signer.SignPayloadManifest(testfactory.TestAccName, manifest))
+	require.Equal(t, signer.Account(testfactory.TestAccName).Address().String(), manifest.Signer)
+	require.NoError(t, manifest.Verify())
+
+	tampered := *manifest
+	tampered.Size = 11
+	require.Error(t, tampered.Verify())
+
+	tampered = *manifest
+	tampered.Signer = testnode.RandomAddress().String()
+	require.Error(t, tampered.Verify())
+
+	require.Error(t, signer.SignPayloadManifest("unknown", manifest))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/celestiaorg/go-square/blob"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -31,6 +32,8 @@ type Signer struct {
 	// set of accounts that the signer can manage. Should match the keys on the keyring
 	accounts            map[string]*Account
 	addressToAccountMap map[string]string
+	// signers of multisig members whose keys are not on the keyring, indexed by address
+	externalSigners map[string]ExternalSigner
 }
 
 // NewSigner returns a new signer using the provided keyring
@@ -49,6 +52,7 @@ func NewSigner(
 		enc:                 encCfg,
 		accounts:            make(map[string]*Account),
 		addressToAccountMap: make(map[string]string),
+		externalSigners:     make(map[string]ExternalSigner),
 		appVersion:          appVersion,
 	}
 
@@ -110,6 +114,66 @@ func (s *Signer) CreatePayForBlobs(accountName string, blobs []*blob.Blob, opts
 	return blobTx, sequence, err
 }
 
+// CreateUnsignedPayForBlobs forms a MsgPayForBlobs transaction from the
+// provided blobs without signing it. The account only needs to be known by the
+// keyring, for example as an offline or multisig key, so that the transaction
+// can be signed elsewhere. TxOptions may be used to set the gas limit and fee.
+func (s *Signer) CreateUnsignedPayForBlobs(accountName string, blobs []*blob.Blob, opts ...TxOption) (*UnsignedBlobTx, error) {
+	acc, exists := s.accounts[accountName]
+	if !exists {
+		return nil, fmt.Errorf("account %s not found", accountName)
+	}
+
+	msg, err := blobtypes.NewMsgPayForBlobs(acc.address.String(), s.appVersion, blobs...)
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := s.txBuilder([]sdktypes.Msg{msg}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewUnsignedBlobTx(s.enc, txBuilder.GetTx(), blobs, s.chainID, acc.accountNumber, acc.sequence)
+}
+
+// SignUnsignedBlobTx signs the UnsignedBlobTx with the key of the provided
+// account using the provided sign mode. If the account is the signer of the
+// transaction, the signature is set on it. Otherwise the account is expected
+// to be a member of a multisig and the signature is only returned so that it
+// can be combined with the signatures of the other members. If the account is
+// a multisig, the signatures of its members available to the signer are
+// combined and set on the transaction, the sign mode is ignored.
+func (s *Signer) SignUnsignedBlobTx(accountName string, utx *UnsignedBlobTx, signMode signing.SignMode) (signing.SignatureV2, error) {
+	record, err := s.keys.Key(accountName)
+	if err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("retrieving key for account %s: %w", accountName, err)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("getting public key for account %s: %w", accountName, err)
+	}
+	if multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey); ok {
+		return s.signUnsignedBlobTxWithMultisig(utx, multisigPub)
+	}
+
+	sig, err := utx.Sign(s.enc, s.keys, accountName, signMode)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	signer, err := utx.SignerAddress(s.enc)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	if signer.Equals(sdktypes.AccAddress(sig.PubKey.Address())) {
+		if err := utx.SetSignatures(s.enc, sig); err != nil {
+			return signing.SignatureV2{}, err
+		}
+	}
+	return sig, nil
+}
+
 func (s *Signer) EncodeTx(tx sdktypes.Tx) ([]byte, error) {
 	return s.enc.TxEncoder()(tx)
 }
@@ -160,6 +224,9 @@ func (s *Signer) findAccount(txbuilder client.TxBuilder) (*Account, error) {
 	if len(signers) == 0 {
 		return nil, fmt.Errorf("message has no signer")
 	}
+	if len(signers) > 1 {
+		return nil, fmt.Errorf("only one signer per transaction supported, got %d", len(signers))
+	}
 	accountName, exists := s.addressToAccountMap[signers[0].String()]
 	if !exists {
 		return nil, fmt.Errorf("account %s not found", signers[0].String())
@@ -224,6 +291,13 @@ func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, erro
 		return "", 0, err
 	}
 
+	if pubKey, ok := account.pubKey.(*kmultisig.LegacyAminoPubKey); ok {
+		if err := s.signMultisigTransaction(builder, account, pubKey); err != nil {
+			return "", 0, err
+		}
+		return account.name, account.sequence, nil
+	}
+
 	// To ensure we have the correct bytes to sign over we produce
 	// a dry run of the signing data
 	err = builder.SetSignatures(s.getSignatureV2(account.sequence, account.pubKey, nil))
@@ -245,6 +319,33 @@ func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, erro
 	return account.name, account.sequence, nil
 }
 
+// draftSignTransaction signs the transaction so that it can be simulated.
+// Multisig transactions get placeholder signatures so that the members are
+// not asked to sign a transaction that won't be broadcast.
+func (s *Signer) draftSignTransaction(builder client.TxBuilder) error {
+	account, err := s.findAccount(builder)
+	if err != nil {
+		return err
+	}
+
+	pubKey, ok := account.pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		_, _, err := s.signTransaction(builder)
+		return err
+	}
+
+	draft, err := draftMultisigSignature(pubKey)
+	if err != nil {
+		return err
+	}
+	// the public key is always set as it affects the size of the transaction
+	err = builder.SetSignatures(signing.SignatureV2{PubKey: pubKey, Data: draft, Sequence: account.sequence})
+	if err != nil {
+		return fmt.Errorf("error setting draft signatures: %w", err)
+	}
+	return nil
+}
+
 func (s *Signer) createSignature(builder client.TxBuilder, account *Account, sequence uint64) ([]byte, error) {
 	signerData := authsigning.SignerData{
 		Address:       account.address.String(),
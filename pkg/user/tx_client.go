@@ -56,12 +56,28 @@ func WithDefaultAddress(address sdktypes.AccAddress) Option {
 	}
 }
 
+// WithExternalSigners is a functional option that registers signers for the
+// members of multisig accounts whose keys are not on the keyring.
+func WithExternalSigners(signers ...ExternalSigner) Option {
+	return func(c *TxClient) {
+		for _, signer := range signers {
+			c.signer.AddExternalSigner(signer)
+		}
+	}
+}
+
 func WithDefaultAccount(name string) Option {
 	return func(c *TxClient) {
-		if _, err := c.signer.keys.Key(name); err != nil {
+		record, err := c.signer.keys.Key(name)
+		if err != nil {
+			panic(err)
+		}
+		addr, err := record.GetAddress()
+		if err != nil {
 			panic(err)
 		}
 		c.defaultAccount = name
+		c.defaultAddress = addr
 	}
 }
 
@@ -80,6 +96,8 @@ type TxClient struct {
 	gasMultiplier  float64
 	defaultAccount string
 	defaultAddress sdktypes.AccAddress
+	// useFeeGrants makes the client pay fees using x/feegrant allowances
+	useFeeGrants bool
 }
 
 // NewTxClient returns a new signer using the provided keyring
@@ -207,10 +225,27 @@ func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, acco
 	}
 
 	gasLimit := uint64(float64(types.DefaultEstimateGas(blobSizes)) * client.gasMultiplier)
+	if client.useFeeGrants {
+		gasLimit += feeGrantGasOverhead
+	}
 	fee := uint64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
 	// prepend calculated params, so it can be overwritten in case the user has specified it.
 	opts = append([]TxOption{SetGasLimit(gasLimit), SetFee(fee)}, opts...)
 
+	if client.useFeeGrants {
+		// the fee grant only depends on the fee and the type of the message
+		// so the share commitments don't need to be computed yet
+		msg := &types.MsgPayForBlobs{Signer: client.signer.accounts[account].address.String()}
+		txBuilder, err := client.signer.txBuilder([]sdktypes.Msg{msg}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if err := client.applyFeeGrant(ctx, client.signer.accounts[account].address, txBuilder); err != nil {
+			return nil, err
+		}
+		opts = append(opts, SetFeeGranter(txBuilder.GetTx().FeeGranter()))
+	}
+
 	txBytes, _, err := client.signer.CreatePayForBlobs(account, blobs, opts...)
 	if err != nil {
 		return nil, err
@@ -219,6 +254,78 @@ func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, acco
 	return client.broadcastTx(ctx, txBytes, account)
 }
 
+// CreateUnsignedPayForBlobs forms an unsigned transaction to pay for blobs
+// that can be signed offline. The gas limit and fee are estimated unless they
+// are provided as TxOptions.
+func (client *TxClient) CreateUnsignedPayForBlobs(ctx context.Context, account string, blobs []*blob.Blob, opts ...TxOption) (*UnsignedBlobTx, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	if err := client.checkAccountLoaded(ctx, account); err != nil {
+		return nil, err
+	}
+
+	blobSizes := make([]uint32, len(blobs))
+	for i, blob := range blobs {
+		blobSizes[i] = uint32(len(blob.Data))
+	}
+
+	gasLimit := uint64(float64(types.DefaultEstimateGas(blobSizes)) * client.gasMultiplier)
+	fee := uint64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
+	// prepend calculated params, so it can be overwritten in case the user has specified it.
+	opts = append([]TxOption{SetGasLimit(gasLimit), SetFee(fee)}, opts...)
+
+	return client.signer.CreateUnsignedPayForBlobs(account, blobs, opts...)
+}
+
+// SubmitSignedBlobTx broadcasts a blob tx that has been signed offline and
+// waits for it to be committed on chain.
+func (client *TxClient) SubmitSignedBlobTx(ctx context.Context, utx *UnsignedBlobTx) (*sdktypes.TxResponse, error) {
+	resp, err := client.BroadcastSignedBlobTx(ctx, utx)
+	if err != nil {
+		return resp, err
+	}
+
+	return client.ConfirmTx(ctx, resp.TxHash)
+}
+
+// BroadcastSignedBlobTx wraps a blob tx that has been signed offline with its
+// blobs and broadcasts it. As the transaction can't be re-signed, it is not
+// retried in case of a sequence mismatch. If the signer of the transaction is
+// an account of the client, its sequence is updated.
+// It does not confirm that the transaction has been committed on chain.
+func (client *TxClient) BroadcastSignedBlobTx(ctx context.Context, utx *UnsignedBlobTx) (*sdktypes.TxResponse, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	blobTx, err := utx.MarshalBlobTx(client.signer.enc)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := sdktx.NewServiceClient(client.grpc).BroadcastTx(
+		ctx,
+		&sdktx.BroadcastTxRequest{
+			Mode:    sdktx.BroadcastMode_BROADCAST_MODE_SYNC,
+			TxBytes: blobTx,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TxResponse.Code != abci.CodeTypeOK {
+		return resp.TxResponse, fmt.Errorf("tx failed with code %d: %s", resp.TxResponse.Code, resp.TxResponse.RawLog)
+	}
+
+	signer, err := utx.SignerAddress(client.signer.enc)
+	if err != nil {
+		return nil, err
+	}
+	if account := client.signer.AccountByAddress(signer); account != nil && account.sequence <= utx.Sequence {
+		account.sequence = utx.Sequence + 1
+	}
+	return resp.TxResponse, nil
+}
+
 // SubmitTx forms a transaction from the provided messages, signs it, and submits it to the chain. TxOptions
 // may be provided to set the fee and gas limit.
 func (client *TxClient) SubmitTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*sdktypes.TxResponse, error) {
@@ -255,12 +362,23 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 		}
 	}
 
+	grantee := client.signer.accounts[account].address
+	userGranter := txBuilder.GetTx().FeeGranter()
+
 	gasLimit := txBuilder.GetTx().GetGas()
 	if gasLimit == 0 {
 		if !hasUserSetFee {
 			// add at least 1utia as fee to builder as it affects gas calculation.
 			txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdktypes.NewInt(1))))
 		}
+		if client.useFeeGrants {
+			// a granter pays for the simulated fee as the grantee may not be
+			// able to. The granter of the transaction is only chosen once its
+			// final fee is known.
+			if err := client.applyFeeGrant(ctx, grantee, txBuilder); err != nil {
+				return nil, err
+			}
+		}
 		gasLimit, err = client.estimateGas(ctx, txBuilder)
 		if err != nil {
 			return nil, err
@@ -273,6 +391,14 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 		txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdktypes.NewInt(fee))))
 	}
 
+	if client.useFeeGrants {
+		// choose the granter that can pay for the final fee
+		txBuilder.SetFeeGranter(userGranter)
+		if err := client.applyFeeGrant(ctx, grantee, txBuilder); err != nil {
+			return nil, err
+		}
+	}
+
 	account, _, err = client.signer.signTransaction(txBuilder)
 	if err != nil {
 		return nil, err
@@ -423,7 +549,7 @@ func (client *TxClient) EstimateGas(ctx context.Context, msgs []sdktypes.Msg, op
 }
 
 func (client *TxClient) estimateGas(ctx context.Context, txBuilder client.TxBuilder) (uint64, error) {
-	_, _, err := client.signer.signTransaction(txBuilder)
+	err := client.signer.draftSignTransaction(txBuilder)
 	if err != nil {
 		return 0, err
 	}
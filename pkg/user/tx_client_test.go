@@ -1,12 +1,21 @@
 package user_test
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -14,9 +23,12 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v2/app"
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v2/pkg/user"
 	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	appns "github.com/celestiaorg/go-square/namespace"
 )
 
 func TestTxClientTestSuite(t *testing.T) {
@@ -43,6 +55,24 @@ func (suite *TxClientTestSuite) SetupSuite() {
 	suite.Require().NoError(err)
 }
 
+// TestDefaultAccount checks that the default address of the client matches
+// the account selected with WithDefaultAccount rather than the first account
+// of the keyring.
+func (suite *TxClientTestSuite) TestDefaultAccount() {
+	t := suite.T()
+	records, err := suite.ctx.Keyring.List()
+	require.NoError(t, err)
+	require.Greater(t, len(records), 1)
+	record := records[len(records)-1]
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	txClient, err := user.SetupTxClient(suite.ctx.GoContext(), suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg, user.WithDefaultAccount(record.Name))
+	require.NoError(t, err)
+	require.Equal(t, record.Name, txClient.DefaultAccountName())
+	require.Equal(t, addr, txClient.DefaultAddress())
+}
+
 func (suite *TxClientTestSuite) TestSubmitPayForBlob() {
 	t := suite.T()
 	blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 1e3, 1e4)
@@ -102,6 +132,264 @@ func (suite *TxClientTestSuite) TestSubmitTx() {
 	})
 }
 
+func (suite *TxClientTestSuite) TestSubmitPayload() {
+	t := suite.T()
+	ns := appns.MustNewV0(bytes.Repeat([]byte{0x1}, appns.NamespaceVersionZeroIDSize))
+	ctx, cancel := context.WithTimeout(suite.ctx.GoContext(), 2*time.Minute)
+	defer cancel()
+
+	t.Run("payload split into several blobs", func(t *testing.T) {
+		payload := rand.Bytes(3500)
+		manifest, err := suite.txClient.SubmitPayload(ctx, ns, bytes.NewReader(payload), user.WithPayloadMaxBlobSize(1000))
+		require.NoError(t, err)
+		require.Len(t, manifest.Entries, 4)
+		require.EqualValues(t, len(payload), manifest.Size)
+		require.NoError(t, manifest.Verify())
+
+		var out bytes.Buffer
+		require.NoError(t, user.FetchPayload(ctx, suite.ctx.GRPCClient, manifest, &out))
+		require.Equal(t, payload, out.Bytes())
+	})
+
+	t.Run("payload split across several PFBs", func(t *testing.T) {
+		largest := blobtypes.LargestBlobSize(appconsts.DefaultGovMaxSquareSize, appconsts.DefaultSubtreeRootThreshold)
+		payload := rand.Bytes(largest + 1000)
+		manifest, err := suite.txClient.SubmitPayload(ctx, ns, bytes.NewReader(payload))
+		require.NoError(t, err)
+		require.Len(t, manifest.Entries, 2)
+
+		var out bytes.Buffer
+		require.NoError(t, user.FetchPayload(ctx, suite.ctx.GRPCClient, manifest, &out))
+		require.Equal(t, payload, out.Bytes())
+	})
+
+	t.Run("tampered manifest", func(t *testing.T) {
+		manifest, err := suite.txClient.SubmitPayload(ctx, ns, bytes.NewReader(rand.Bytes(100)))
+		require.NoError(t, err)
+		manifest.Entries[0].Commitment = bytes.Repeat([]byte{0x1}, 32)
+		require.Error(t, user.FetchPayload(ctx, suite.ctx.GRPCClient, manifest, io.Discard))
+	})
+
+	t.Run("empty payload", func(t *testing.T) {
+		_, err := suite.txClient.SubmitPayload(ctx, ns, bytes.NewReader(nil))
+		require.Error(t, err)
+	})
+}
+
+func (suite *TxClientTestSuite) TestSubmitSignedBlobTx() {
+	t := suite.T()
+	ctx := suite.ctx.GoContext()
+	txConfig := suite.encCfg.TxConfig
+	blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 1e3)
+
+	t.Run("single signer", func(t *testing.T) {
+		account := suite.txClient.DefaultAccountName()
+		utx, err := suite.txClient.CreateUnsignedPayForBlobs(ctx, account, blobs)
+		require.NoError(t, err)
+
+		_, err = suite.txClient.Signer().SignUnsignedBlobTx(account, utx, signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		resp, err := suite.txClient.SubmitSignedBlobTx(ctx, utx)
+		require.NoError(t, err)
+		require.EqualValues(t, abci.CodeTypeOK, resp.Code)
+
+		// the sequence of the account is tracked so it can keep submitting
+		resp, err = suite.txClient.SubmitPayForBlobsWithAccount(ctx, account, blobs)
+		require.NoError(t, err)
+		require.EqualValues(t, abci.CodeTypeOK, resp.Code)
+
+		// the same transaction can't be broadcast twice
+		_, err = suite.txClient.BroadcastSignedBlobTx(ctx, utx)
+		require.Error(t, err)
+	})
+
+	t.Run("multisig", func(t *testing.T) {
+		members := []string{"member1", "member2", "member3"}
+		pubKeys := make([]cryptotypes.PubKey, len(members))
+		for i, name := range members {
+			record, _, err := suite.ctx.Keyring.NewMnemonic(name, keyring.English, "", "", hd.Secp256k1)
+			require.NoError(t, err)
+			pubKeys[i], err = record.GetPubKey()
+			require.NoError(t, err)
+		}
+		multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+		_, err := suite.ctx.Keyring.SaveMultisig("multisig", multisigPub)
+		require.NoError(t, err)
+
+		// fund the multisig so that it exists on chain
+		msg := bank.NewMsgSend(suite.txClient.DefaultAddress(), sdk.AccAddress(multisigPub.Address()), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1e9)))
+		_, err = suite.txClient.SubmitTx(ctx, []sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e6))
+		require.NoError(t, err)
+
+		utx, err := suite.txClient.CreateUnsignedPayForBlobs(ctx, "multisig", blobs, user.SetGasLimit(1e6), user.SetFee(1e6))
+		require.NoError(t, err)
+
+		// each member signs on their own machine
+		var sigs []signing.SignatureV2
+		for _, name := range members[:2] {
+			sig, err := utx.Sign(txConfig, suite.ctx.Keyring, name, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			require.NoError(t, err)
+			sigs = append(sigs, sig)
+		}
+		multisigSig, err := utx.CombineMultisigSignatures(txConfig, multisigPub, sigs...)
+		require.NoError(t, err)
+		require.NoError(t, utx.SetSignatures(txConfig, multisigSig))
+
+		resp, err := suite.txClient.SubmitSignedBlobTx(ctx, utx)
+		require.NoError(t, err)
+		require.EqualValues(t, abci.CodeTypeOK, resp.Code)
+	})
+}
+
+func (suite *TxClientTestSuite) TestMultisigAccount() {
+	t := suite.T()
+	ctx := suite.ctx.GoContext()
+
+	members := []string{"treasurer1", "treasurer2", "treasurer3"}
+	pubKeys := make([]cryptotypes.PubKey, len(members))
+	for i, name := range members {
+		record, _, err := suite.ctx.Keyring.NewMnemonic(name, keyring.English, "", "", hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys[i], err = record.GetPubKey()
+		require.NoError(t, err)
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	_, err := suite.ctx.Keyring.SaveMultisig("treasury", multisigPub)
+	require.NoError(t, err)
+	treasury := sdk.AccAddress(multisigPub.Address())
+
+	// fund the multisig so that it exists on chain
+	msg := bank.NewMsgSend(suite.txClient.DefaultAddress(), treasury, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1e9)))
+	_, err = suite.txClient.SubmitTx(ctx, []sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e6))
+	require.NoError(t, err)
+
+	// the multisig submits several transactions in a row so that the
+	// sequence is tracked locally
+	for i := 0; i < 2; i++ {
+		resp, err := suite.txClient.SubmitPayForBlobsWithAccount(ctx, "treasury", blobfactory.ManyRandBlobs(rand.NewRand(), 1e3))
+		require.NoError(t, err)
+		require.EqualValues(t, abci.CodeTypeOK, resp.Code)
+
+		msg := bank.NewMsgSend(treasury, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+		resp, err = suite.txClient.SubmitTx(ctx, []sdk.Msg{msg})
+		require.NoError(t, err)
+		require.EqualValues(t, abci.CodeTypeOK, resp.Code)
+	}
+
+	account, exists := suite.txClient.Account("treasury")
+	require.True(t, exists)
+	require.True(t, account.IsMultisig())
+	require.EqualValues(t, 4, account.Sequence())
+}
+
+func (suite *TxClientTestSuite) TestFeeGrants() {
+	t := suite.T()
+	ctx := suite.ctx.GoContext()
+	granter := suite.txClient.DefaultAddress()
+
+	record, _, err := suite.ctx.Keyring.NewMnemonic("grantee", keyring.English, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+	grantee, err := record.GetAddress()
+	require.NoError(t, err)
+
+	// the grantee only holds enough funds to exist on chain
+	msg := bank.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+	_, err = suite.txClient.SubmitTx(ctx, []sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e6))
+	require.NoError(t, err)
+
+	expiration := time.Now().Add(time.Hour)
+	basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1e6)), Expiration: &expiration}
+	allowance, err := feegrant.NewAllowedMsgAllowance(basic, []string{sdk.MsgTypeURL(&blobtypes.MsgPayForBlobs{})})
+	require.NoError(t, err)
+	grantMsg, err := feegrant.NewMsgGrantAllowance(allowance, granter, grantee)
+	require.NoError(t, err)
+	_, err = suite.txClient.SubmitTx(ctx, []sdk.Msg{grantMsg}, user.SetGasLimit(1e6), user.SetFee(1e6))
+	require.NoError(t, err)
+
+	txClient, err := user.SetupTxClient(ctx, suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg, user.WithFeeGrants())
+	require.NoError(t, err)
+
+	grants, err := txClient.FeeGrants(ctx, "grantee")
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	require.Equal(t, granter, grants[0].Granter)
+	require.Equal(t, expiration.Unix(), grants[0].Expiration().Unix())
+
+	t.Run("granter is chosen automatically", func(t *testing.T) {
+		resp, err := txClient.SubmitPayForBlobsWithAccount(ctx, "grantee", blobfactory.ManyRandBlobs(rand.NewRand(), 1e3))
+		require.NoError(t, err)
+		require.EqualValues(t, abci.CodeTypeOK, resp.Code)
+
+		grant, err := txClient.FeeGrant(ctx, granter, grantee)
+		require.NoError(t, err)
+		spendLimit, limited := grant.SpendLimit(time.Now())
+		require.True(t, limited)
+		require.True(t, spendLimit.AmountOf(app.BondDenom).LT(sdk.NewInt(1e6)))
+	})
+
+	t.Run("message not allowed by the grant", func(t *testing.T) {
+		msg := bank.NewMsgSend(grantee, granter, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+		_, err := txClient.BroadcastTx(ctx, []sdk.Msg{msg})
+		require.ErrorContains(t, err, "no fee grant can pay for the transaction")
+	})
+
+	t.Run("fee above the spend limit", func(t *testing.T) {
+		_, err := txClient.BroadcastPayForBlobWithAccount(ctx, "grantee", blobfactory.ManyRandBlobs(rand.NewRand(), 1e3), user.SetFee(1e7))
+		require.ErrorContains(t, err, "fee limit exceeded")
+	})
+
+	t.Run("granter without a grant", func(t *testing.T) {
+		_, err := txClient.BroadcastPayForBlobWithAccount(ctx, "grantee", blobfactory.ManyRandBlobs(rand.NewRand(), 1e3), user.SetFeeGranter(grantee))
+		require.Error(t, err)
+	})
+
+	t.Run("granter is chosen for the final fee", func(t *testing.T) {
+		// the grants are listed in the order of their granter, so the granter
+		// of the grant that only covers the simulated fee comes first
+		var smallGranter sdk.AccAddress
+		for i := 0; smallGranter == nil || bytes.Compare(smallGranter, granter) > 0; i++ {
+			record, _, err := suite.ctx.Keyring.NewMnemonic(fmt.Sprintf("small-granter-%d", i), keyring.English, "", "", hd.Secp256k1)
+			require.NoError(t, err)
+			smallGranter, err = record.GetAddress()
+			require.NoError(t, err)
+		}
+		record, _, err := suite.ctx.Keyring.NewMnemonic("final-fee-grantee", keyring.English, "", "", hd.Secp256k1)
+		require.NoError(t, err)
+		finalGrantee, err := record.GetAddress()
+		require.NoError(t, err)
+
+		small := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10))}
+		smallGrant, err := feegrant.NewMsgGrantAllowance(small, smallGranter, finalGrantee)
+		require.NoError(t, err)
+		largeGrant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{}, granter, finalGrantee)
+		require.NoError(t, err)
+		_, err = suite.txClient.SubmitTx(ctx, []sdk.Msg{
+			bank.NewMsgSend(granter, smallGranter, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 2e6))),
+			bank.NewMsgSend(granter, finalGrantee, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1))),
+			largeGrant,
+		}, user.SetGasLimit(1e6), user.SetFee(1e6))
+		require.NoError(t, err)
+		// the small granter pays for its grant itself
+		granterClient, err := user.SetupTxClient(ctx, suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg)
+		require.NoError(t, err)
+		_, err = granterClient.SubmitTx(ctx, []sdk.Msg{smallGrant}, user.SetGasLimit(1e6), user.SetFee(1e6))
+		require.NoError(t, err)
+
+		txClient, err := user.SetupTxClient(ctx, suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg, user.WithFeeGrants())
+		require.NoError(t, err)
+		msg := bank.NewMsgSend(finalGrantee, granter, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+		resp, err := txClient.SubmitTx(ctx, []sdk.Msg{msg})
+		require.NoError(t, err)
+		require.EqualValues(t, abci.CodeTypeOK, resp.Code)
+
+		// the small grant was not used
+		grant, err := txClient.FeeGrant(ctx, smallGranter, finalGrantee)
+		require.NoError(t, err)
+		spendLimit, _ := grant.SpendLimit(time.Now())
+		require.Equal(t, int64(10), spendLimit.AmountOf(app.BondDenom).Int64())
+	})
+}
+
 func (suite *TxClientTestSuite) TestConfirmTx() {
 	t := suite.T()
 
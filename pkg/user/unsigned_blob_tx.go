@@ -0,0 +1,309 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/go-square/blob"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+)
+
+// UnsignedBlobTx is a MsgPayForBlobs transaction together with the blobs it
+// pays for in a portable JSON format. It carries everything a signer needs to
+// sign the transaction offline (for example on an air-gapped machine or by
+// each member of a multisig) so that the signatures can be collected, added
+// to the transaction and the resulting BlobTx broadcast from a machine that is
+// connected to the network.
+//
+// The Tx field is the JSON encoding of the sdk transaction. It can be
+// extracted and signed using the standard `tx sign --signature-only` and
+// `tx multisign` flows.
+type UnsignedBlobTx struct {
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number"`
+	Sequence      uint64          `json:"sequence"`
+	Tx            json.RawMessage `json:"tx"`
+	Blobs         []*blob.Blob    `json:"blobs"`
+}
+
+// NewUnsignedBlobTx returns an UnsignedBlobTx for the provided transaction
+// and blobs. The transaction must contain a single MsgPayForBlobs. The
+// account number and sequence are those of the account that signs it.
+func NewUnsignedBlobTx(
+	txConfig client.TxConfig,
+	tx sdktypes.Tx,
+	blobs []*blob.Blob,
+	chainID string,
+	accountNumber, sequence uint64,
+) (*UnsignedBlobTx, error) {
+	if err := validatePayForBlobsTx(tx, blobs); err != nil {
+		return nil, err
+	}
+	txJSON, err := txConfig.TxJSONEncoder()(tx)
+	if err != nil {
+		return nil, fmt.Errorf("encoding tx: %w", err)
+	}
+	return &UnsignedBlobTx{
+		ChainID:       chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		Tx:            txJSON,
+		Blobs:         blobs,
+	}, nil
+}
+
+// UnmarshalUnsignedBlobTx decodes an UnsignedBlobTx from its JSON encoding
+// and checks that it contains a single MsgPayForBlobs along with its blobs.
+func UnmarshalUnsignedBlobTx(txConfig client.TxConfig, bz []byte) (*UnsignedBlobTx, error) {
+	var utx UnsignedBlobTx
+	if err := json.Unmarshal(bz, &utx); err != nil {
+		return nil, fmt.Errorf("decoding unsigned blob tx: %w", err)
+	}
+	tx, err := utx.GetTx(txConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePayForBlobsTx(tx, utx.Blobs); err != nil {
+		return nil, err
+	}
+	return &utx, nil
+}
+
+// GetTx decodes the sdk transaction of the UnsignedBlobTx.
+func (u *UnsignedBlobTx) GetTx(txConfig client.TxConfig) (authsigning.Tx, error) {
+	tx, err := txConfig.TxJSONDecoder()(u.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("decoding tx: %w", err)
+	}
+	authTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, errors.New("not an authsigning transaction")
+	}
+	return authTx, nil
+}
+
+// SignerAddress returns the address of the account that has to sign the
+// transaction. In case of a multisig account, it is the multisig's address.
+func (u *UnsignedBlobTx) SignerAddress(txConfig client.TxConfig) (sdktypes.AccAddress, error) {
+	tx, err := u.GetTx(txConfig)
+	if err != nil {
+		return nil, err
+	}
+	return tx.GetSigners()[0], nil
+}
+
+// SignBytes returns the bytes that the key with the provided public key has
+// to sign using the provided sign mode. It can be used to sign the
+// transaction with an external signer such as a hardware wallet. Members of
+// a multisig must use SIGN_MODE_LEGACY_AMINO_JSON.
+func (u *UnsignedBlobTx) SignBytes(txConfig client.TxConfig, signMode signing.SignMode, pubKey cryptotypes.PubKey) ([]byte, error) {
+	tx, err := u.GetTx(txConfig)
+	if err != nil {
+		return nil, err
+	}
+	builder, err := txConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+	if signMode == signing.SignMode_SIGN_MODE_DIRECT {
+		// direct sign bytes include the signer infos so we produce a dry run
+		// of the signature before getting the bytes to sign over
+		err = builder.SetSignatures(u.signatureV2(pubKey, &signing.SingleSignatureData{SignMode: signMode}))
+		if err != nil {
+			return nil, fmt.Errorf("error setting draft signatures: %w", err)
+		}
+	}
+	bytesToSign, err := txConfig.SignModeHandler().GetSignBytes(signMode, u.signerData(tx, pubKey), builder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("error getting sign bytes: %w", err)
+	}
+	return bytesToSign, nil
+}
+
+// Sign signs the transaction with the key of the provided name in the
+// keyring and returns the signature. The signature is not added to the
+// transaction, use SetSignatures or CombineMultisigSignatures for that.
+func (u *UnsignedBlobTx) Sign(txConfig client.TxConfig, keys keyring.Keyring, keyName string, signMode signing.SignMode) (signing.SignatureV2, error) {
+	record, err := keys.Key(keyName)
+	if err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("retrieving key %s: %w", keyName, err)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("getting public key for key %s: %w", keyName, err)
+	}
+	bytesToSign, err := u.SignBytes(txConfig, signMode, pubKey)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	signature, _, err := keys.Sign(keyName, bytesToSign)
+	if err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("error signing bytes: %w", err)
+	}
+	return u.signatureV2(pubKey, &signing.SingleSignatureData{SignMode: signMode, Signature: signature}), nil
+}
+
+// CombineMultisigSignatures verifies the signatures of the members of the
+// provided legacy amino multisig and combines them into a single multisig
+// signature. The members must have signed using SIGN_MODE_LEGACY_AMINO_JSON.
+// The returned signature can be added to the transaction using
+// SetSignatures.
+func (u *UnsignedBlobTx) CombineMultisigSignatures(
+	txConfig client.TxConfig,
+	multisigPubKey cryptotypes.PubKey,
+	sigs ...signing.SignatureV2,
+) (signing.SignatureV2, error) {
+	multisigPub, ok := multisigPubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return signing.SignatureV2{}, fmt.Errorf("expected a legacy amino multisig public key, got %T", multisigPubKey)
+	}
+	if len(sigs) < int(multisigPub.Threshold) {
+		return signing.SignatureV2{}, fmt.Errorf("got %d signatures, the multisig threshold is %d", len(sigs), multisigPub.Threshold)
+	}
+	tx, err := u.GetTx(txConfig)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+	for _, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+			return signing.SignatureV2{}, errors.New("multisig members must sign using SIGN_MODE_LEGACY_AMINO_JSON")
+		}
+		if sig.Sequence != u.Sequence {
+			return signing.SignatureV2{}, fmt.Errorf("signature sequence %d does not match the tx sequence %d", sig.Sequence, u.Sequence)
+		}
+		err := authsigning.VerifySignature(sig.PubKey, u.signerData(tx, sig.PubKey), sig.Data, txConfig.SignModeHandler(), tx)
+		if err != nil {
+			return signing.SignatureV2{}, fmt.Errorf("verifying signature of %s: %w", sdktypes.AccAddress(sig.PubKey.Address()), err)
+		}
+		if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
+			return signing.SignatureV2{}, err
+		}
+	}
+	return u.signatureV2(multisigPub, multisigSig), nil
+}
+
+// SetSignatures verifies the provided signatures and sets them on the
+// transaction.
+func (u *UnsignedBlobTx) SetSignatures(txConfig client.TxConfig, sigs ...signing.SignatureV2) error {
+	tx, err := u.GetTx(txConfig)
+	if err != nil {
+		return err
+	}
+	builder, err := txConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return err
+	}
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return fmt.Errorf("error setting signatures: %w", err)
+	}
+	for _, sig := range sigs {
+		if sig.PubKey == nil {
+			return errors.New("signature is missing the public key of the signer")
+		}
+		if sig.Sequence != u.Sequence {
+			return fmt.Errorf("signature sequence %d does not match the tx sequence %d", sig.Sequence, u.Sequence)
+		}
+		err := authsigning.VerifySignature(sig.PubKey, u.signerData(tx, sig.PubKey), sig.Data, txConfig.SignModeHandler(), builder.GetTx())
+		if err != nil {
+			return fmt.Errorf("verifying signature of %s: %w", sdktypes.AccAddress(sig.PubKey.Address()), err)
+		}
+	}
+	txJSON, err := txConfig.TxJSONEncoder()(builder.GetTx())
+	if err != nil {
+		return fmt.Errorf("encoding tx: %w", err)
+	}
+	u.Tx = txJSON
+	return nil
+}
+
+// IsSigned returns true if the transaction carries a signature.
+func (u *UnsignedBlobTx) IsSigned(txConfig client.TxConfig) (bool, error) {
+	tx, err := u.GetTx(txConfig)
+	if err != nil {
+		return false, err
+	}
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return false, err
+	}
+	return len(sigs) > 0, nil
+}
+
+// MarshalBlobTx wraps the signed transaction and its blobs into a BlobTx
+// ready to be broadcast.
+func (u *UnsignedBlobTx) MarshalBlobTx(txConfig client.TxConfig) ([]byte, error) {
+	signed, err := u.IsSigned(txConfig)
+	if err != nil {
+		return nil, err
+	}
+	if !signed {
+		return nil, errors.New("blob tx has not been signed")
+	}
+	tx, err := u.GetTx(txConfig)
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := txConfig.TxEncoder()(tx)
+	if err != nil {
+		return nil, err
+	}
+	return blob.MarshalBlobTx(txBytes, u.Blobs...)
+}
+
+func (u *UnsignedBlobTx) signerData(tx authsigning.Tx, pubKey cryptotypes.PubKey) authsigning.SignerData {
+	return authsigning.SignerData{
+		Address:       tx.GetSigners()[0].String(),
+		ChainID:       u.ChainID,
+		AccountNumber: u.AccountNumber,
+		Sequence:      u.Sequence,
+		PubKey:        pubKey,
+	}
+}
+
+func (u *UnsignedBlobTx) signatureV2(pubKey cryptotypes.PubKey, data signing.SignatureData) signing.SignatureV2 {
+	// unlike signatures created by the Signer, the public key is always set as
+	// the account of an offline signer may not be known to the network yet
+	return signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     data,
+		Sequence: u.Sequence,
+	}
+}
+
+// validatePayForBlobsTx checks that the transaction contains a single
+// MsgPayForBlobs paying for the provided blobs.
+func validatePayForBlobsTx(tx sdktypes.Tx, blobs []*blob.Blob) error {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return blobtypes.ErrMultipleMsgsInBlobTx
+	}
+	msg, ok := msgs[0].(*blobtypes.MsgPayForBlobs)
+	if !ok {
+		return blobtypes.ErrNoPFB
+	}
+	if len(blobs) == 0 {
+		return blobtypes.ErrNoBlobs
+	}
+	if len(msg.BlobSizes) != len(blobs) {
+		return blobtypes.ErrBlobSizeMismatch.Wrapf("got %d blobs, the PFB pays for %d", len(blobs), len(msg.BlobSizes))
+	}
+	for i, b := range blobs {
+		if uint32(len(b.Data)) != msg.BlobSizes[i] {
+			return blobtypes.ErrBlobSizeMismatch.Wrapf("blob %d has size %d, the PFB pays for %d", i, len(b.Data), msg.BlobSizes[i])
+		}
+	}
+	return nil
+}
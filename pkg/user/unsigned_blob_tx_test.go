@@ -0,0 +1,129 @@
+package user_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/celestiaorg/go-square/blob"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/user"
+	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+)
+
+func TestUnsignedBlobTx(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 100, 1000)
+
+	utx, err := signer.CreateUnsignedPayForBlobs(testfactory.TestAccName, blobs, user.SetGasLimit(1e6), user.SetFee(1e4))
+	require.NoError(t, err)
+	require.Equal(t, testfactory.ChainID, utx.ChainID)
+
+	signed, err := utx.IsSigned(encCfg.TxConfig)
+	require.NoError(t, err)
+	require.False(t, signed)
+	_, err = utx.MarshalBlobTx(encCfg.TxConfig)
+	require.Error(t, err)
+
+	// the unsigned blob tx is moved to the signer in its JSON encoding
+	bz, err := json.Marshal(utx)
+	require.NoError(t, err)
+	utx, err = user.UnmarshalUnsignedBlobTx(encCfg.TxConfig, bz)
+	require.NoError(t, err)
+	require.Equal(t, blobs, utx.Blobs)
+
+	_, err = signer.SignUnsignedBlobTx(testfactory.TestAccName, utx, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	blobTxBytes, err := utx.MarshalBlobTx(encCfg.TxConfig)
+	require.NoError(t, err)
+	blobTx, isBlobTx := blob.UnmarshalBlobTx(blobTxBytes)
+	require.True(t, isBlobTx)
+	require.Equal(t, blobs, blobTx.Blobs)
+	tx, err := signer.DecodeTx(blobTx.Tx)
+	require.NoError(t, err)
+	sigs, err := tx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+
+	// a signature over a different sequence is rejected
+	other := *utx
+	other.Sequence++
+	sig, err := other.Sign(encCfg.TxConfig, signer.Keyring(), testfactory.TestAccName, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.Error(t, utx.SetSignatures(encCfg.TxConfig, sig))
+
+	// the blobs must match the PFB
+	bz, err = json.Marshal(user.UnsignedBlobTx{ChainID: utx.ChainID, Tx: utx.Tx, Blobs: blobs[:1]})
+	require.NoError(t, err)
+	_, err = user.UnmarshalUnsignedBlobTx(encCfg.TxConfig, bz)
+	require.Error(t, err)
+}
+
+func TestUnsignedBlobTxMultisig(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	members := []string{"k1", "k2", "k3"}
+	kr, _ := testnode.NewKeyring(members...)
+	pubKeys := make([]cryptotypes.PubKey, len(members))
+	for i, name := range members {
+		record, err := kr.Key(name)
+		require.NoError(t, err)
+		pubKeys[i], err = record.GetPubKey()
+		require.NoError(t, err)
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	_, err := kr.SaveMultisig("multisig", multisigPub)
+	require.NoError(t, err)
+
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, appconsts.LatestVersion, user.NewAccount("multisig", 1, 2))
+	require.NoError(t, err)
+	blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 100)
+	utx, err := signer.CreateUnsignedPayForBlobs("multisig", blobs, user.SetGasLimit(1e6), user.SetFee(1e4))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, utx.AccountNumber)
+	require.EqualValues(t, 2, utx.Sequence)
+
+	sign := func(name string, signMode signing.SignMode) signing.SignatureV2 {
+		sig, err := signer.SignUnsignedBlobTx(name, utx, signMode)
+		require.NoError(t, err)
+		return sig
+	}
+	sig1 := sign("k1", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	sig3 := sign("k3", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+
+	// signatures of the members are not set on the transaction
+	signed, err := utx.IsSigned(encCfg.TxConfig)
+	require.NoError(t, err)
+	require.False(t, signed)
+
+	_, err = utx.CombineMultisigSignatures(encCfg.TxConfig, multisigPub, sig1)
+	require.Error(t, err, "threshold not reached")
+	_, err = utx.CombineMultisigSignatures(encCfg.TxConfig, pubKeys[0], sig1, sig3)
+	require.Error(t, err, "not a multisig public key")
+	_, err = utx.CombineMultisigSignatures(encCfg.TxConfig, multisigPub, sig1, sign("k2", signing.SignMode_SIGN_MODE_DIRECT))
+	require.Error(t, err, "wrong sign mode")
+
+	// signatures are exchanged in the same format as `tx sign --signature-only`
+	bz, err := encCfg.TxConfig.MarshalSignatureJSON([]signing.SignatureV2{sig1, sig3})
+	require.NoError(t, err)
+	sigs, err := encCfg.TxConfig.UnmarshalSignatureJSON(bz)
+	require.NoError(t, err)
+
+	multisigSig, err := utx.CombineMultisigSignatures(encCfg.TxConfig, multisigPub, sigs...)
+	require.NoError(t, err)
+	require.NoError(t, utx.SetSignatures(encCfg.TxConfig, multisigSig))
+
+	_, err = utx.MarshalBlobTx(encCfg.TxConfig)
+	require.NoError(t, err)
+}
@@ -36,6 +36,10 @@ type ErasuredNamespacedMerkleTree struct {
 	// leaf belongs to, along with keeping track of how many leaves have been
 	// added to the tree so far.
 	shareIndex uint64
+	// buffer is the arena from which the namespaced leaves pushed to the
+	// underlying tree are carved. The underlying tree keeps references to its
+	// leaves, so the buffer can only be reused once the tree is discarded.
+	buffer []byte
 }
 
 // Tree is an interface that wraps the methods of the underlying
@@ -48,6 +52,13 @@ type Tree interface {
 	ProveRange(start, end int) (nmt.Proof, error)
 }
 
+// NamespaceProver is implemented by the trees that can prove the presence or
+// absence of a namespace. It is kept apart from Tree so that the existing
+// implementations of Tree don't have to implement it.
+type NamespaceProver interface {
+	ProveNamespace(nID namespace.ID) (nmt.Proof, error)
+}
+
 // NewErasuredNamespacedMerkleTree creates a new ErasuredNamespacedMerkleTree
 // with an underlying NMT of namespace size `appconsts.NamespaceSize` and with
 // `ignoreMaxNamespace=true`. axisIndex is the index of the row or column that
@@ -97,7 +108,7 @@ func (w *ErasuredNamespacedMerkleTree) Push(data []byte) error {
 	if len(data) < appconsts.NamespaceSize {
 		return fmt.Errorf("data is too short to contain namespace ID")
 	}
-	nidAndData := make([]byte, appconsts.NamespaceSize+len(data))
+	nidAndData := w.alloc(appconsts.NamespaceSize + len(data))
 	copy(nidAndData[appconsts.NamespaceSize:], data)
 	// use the parity namespace if the cell is not in Q0 of the extended data square
 	if w.isQuadrantZero() {
@@ -128,6 +139,31 @@ func (w *ErasuredNamespacedMerkleTree) ProveRange(start, end int) (nmt.Proof, er
 	return w.tree.ProveRange(start, end)
 }
 
+// ProveNamespace returns a Merkle proof for the provided namespace. If the
+// namespace is not present in the tree, the proof is an absence proof, or an
+// empty proof if the namespace is outside of the namespace range of the tree.
+// It returns an error if the underlying tree doesn't implement NamespaceProver.
+func (w *ErasuredNamespacedMerkleTree) ProveNamespace(nID namespace.ID) (nmt.Proof, error) {
+	prover, ok := w.tree.(NamespaceProver)
+	if !ok {
+		return nmt.Proof{}, fmt.Errorf("tree of type %T cannot prove namespaces", w.tree)
+	}
+	return prover.ProveNamespace(nID)
+}
+
+// alloc returns a slice of the provided size carved from the buffer of the
+// tree. The buffer is sized for the remaining shares of the axis, so pushing
+// the shares of an axis allocates once instead of once per share.
+func (w *ErasuredNamespacedMerkleTree) alloc(size int) []byte {
+	if cap(w.buffer)-len(w.buffer) < size {
+		remaining := int(2*w.squareSize - w.shareIndex)
+		w.buffer = make([]byte, 0, size*remaining)
+	}
+	start := len(w.buffer)
+	w.buffer = w.buffer[:start+size]
+	return w.buffer[start : start+size : start+size]
+}
+
 // incrementShareIndex increments the share index by one.
 func (w *ErasuredNamespacedMerkleTree) incrementShareIndex() {
 	w.shareIndex++
@@ -178,3 +178,41 @@ func TestErasuredNamespacedMerkleTree_ProveRange(t *testing.T) {
 		}
 	}
 }
+
+// TestErasuredNamespacedMerkleTree_ProveNamespace checks that the proofs
+// returned by ProveNamespace verify for present and absent namespaces.
+func TestErasuredNamespacedMerkleTree_ProveNamespace(t *testing.T) {
+	squareSize := 8
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), 0)
+	data := generateErasuredData(t, squareSize, appconsts.DefaultCodec())
+	for _, d := range data {
+		assert.NoError(t, tree.Push(d))
+	}
+	root, err := tree.Root()
+	assert.NoError(t, err)
+
+	present := nmtnamespace.ID(data[0][:appconsts.NamespaceSize])
+	proof, err := tree.ProveNamespace(present)
+	assert.NoError(t, err)
+	assert.False(t, proof.IsOfAbsence())
+	assert.True(t, proof.VerifyNamespace(appconsts.NewBaseHashFunc(), present, [][]byte{append(present, data[0]...)}, root))
+
+	// the minimum namespace is absent as the namespaces are random
+	absent := nmtnamespace.ID(bytes.Repeat([]byte{0}, appconsts.NamespaceSize))
+	proof, err = tree.ProveNamespace(absent)
+	assert.NoError(t, err)
+	assert.True(t, proof.IsEmptyProof())
+	assert.True(t, proof.VerifyNamespace(appconsts.NewBaseHashFunc(), absent, nil, root))
+}
+
+// rangeOnlyTree is a Tree that doesn't implement NamespaceProver.
+type rangeOnlyTree struct {
+	wrapper.Tree
+}
+
+func TestErasuredNamespacedMerkleTree_ProveNamespaceUnsupported(t *testing.T) {
+	tree := wrapper.NewErasuredNamespacedMerkleTree(1, 0)
+	tree.SetTree(rangeOnlyTree{nmt.New(appconsts.NewBaseHashFunc(), nmt.NamespaceIDSize(appconsts.NamespaceSize))})
+	_, err := tree.ProveNamespace(appns.TxNamespace.Bytes())
+	assert.Error(t, err)
+}
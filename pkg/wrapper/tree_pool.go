@@ -0,0 +1,137 @@
+package wrapper
+
+import (
+	"errors"
+	"hash"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+var _ rsmt2d.Tree = &pooledTree{}
+
+// TreePool recycles the trees used to compute the row and column roots of
+// extended data squares. Computing the roots of a square of width 2k builds 4k
+// trees that are discarded as soon as their root is computed, so the pool
+// reuses their leaf buffers and hashers across axes and blocks instead. A
+// TreePool is safe for concurrent use.
+type TreePool struct {
+	mu sync.Mutex
+	// pools holds a pool of trees per square size, because the leaf buffers
+	// are sized for the width of the square.
+	pools map[uint64]*sync.Pool
+}
+
+// NewTreePool returns an empty TreePool.
+func NewTreePool() *TreePool {
+	return &TreePool{pools: make(map[uint64]*sync.Pool)}
+}
+
+// NewConstructor creates a tree constructor function as required by rsmt2d to
+// calculate the data root. The state of the trees is taken from the pool and
+// returns to it as soon as their root is computed, so the trees only compute
+// their root once and reject the shares pushed after it. This is how rsmt2d
+// uses the trees it constructs, but the constructor must not be kept by an
+// extended data square that outlives the root computation, and trees used to
+// generate proofs must be created with NewConstructor instead. The roots are
+// identical to the ones of the trees created by NewConstructor. squareSize must
+// be greater than zero.
+func (p *TreePool) NewConstructor(squareSize uint64) rsmt2d.TreeConstructorFn {
+	if squareSize == 0 {
+		panic("cannot create a ErasuredNamespacedMerkleTree of squareSize == 0")
+	}
+	pool := p.pool(squareSize)
+	return func(_ rsmt2d.Axis, axisIndex uint) rsmt2d.Tree {
+		state := pool.Get().(*treeState)
+		state.reset(axisIndex)
+		return &pooledTree{state: state, pool: pool}
+	}
+}
+
+func (p *TreePool) pool(squareSize uint64) *sync.Pool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pool, ok := p.pools[squareSize]; ok {
+		return pool
+	}
+	pool := &sync.Pool{}
+	pool.New = func() any {
+		return newTreeState(squareSize)
+	}
+	p.pools[squareSize] = pool
+	return pool
+}
+
+// pooledTree is an ErasuredNamespacedMerkleTree whose state returns to its
+// pool once its root is computed. It only exposes the methods of the
+// rsmt2d.Tree interface, because the underlying tree is discarded when calling
+// Root.
+type pooledTree struct {
+	// state is nil once the root is computed.
+	state *treeState
+	pool  *sync.Pool
+	root  []byte
+	err   error
+}
+
+// treeState is the reusable state of a pooledTree.
+type treeState struct {
+	tree ErasuredNamespacedMerkleTree
+	// baseHasher is the hash function of the hasher shared by the successive
+	// underlying trees.
+	baseHasher hash.Hash
+}
+
+func newTreeState(squareSize uint64) *treeState {
+	// the hasher is shared by the successive underlying trees. This is safe
+	// because a tree is only used by one goroutine until it returns to the pool.
+	baseHasher := appconsts.NewBaseHashFunc()
+	hasher := nmt.NewNmtHasher(baseHasher, appconsts.NamespaceSize, true)
+	return &treeState{
+		tree: ErasuredNamespacedMerkleTree{
+			squareSize: squareSize,
+			options: []nmt.Option{
+				nmt.NamespaceIDSize(appconsts.NamespaceSize),
+				nmt.IgnoreMaxNamespace(true),
+				nmt.InitialCapacity(int(2 * squareSize)),
+				nmt.CustomHasher(hasher),
+			},
+		},
+		baseHasher: baseHasher,
+	}
+}
+
+// reset prepares the state for the axis at the provided index. The leaf buffer
+// of the previous tree is reused since that tree was discarded.
+func (s *treeState) reset(axisIndex uint) {
+	s.tree.axisIndex = uint64(axisIndex)
+	s.tree.shareIndex = 0
+	s.tree.buffer = s.tree.buffer[:0]
+	s.tree.tree = nmt.New(s.baseHasher, s.tree.options...)
+}
+
+// Push fulfills the rsmt2d.Tree interface. See
+// ErasuredNamespacedMerkleTree.Push. It returns an error once the root is
+// computed.
+func (t *pooledTree) Push(data []byte) error {
+	if t.state == nil {
+		return errors.New("cannot push to a pooled tree after computing its root")
+	}
+	return t.state.tree.Push(data)
+}
+
+// Root fulfills the rsmt2d.Tree interface by returning the root of the
+// underlying tree. The first call returns the state of the tree to its pool
+// and the following ones return the same root.
+func (t *pooledTree) Root() ([]byte, error) {
+	if t.state == nil {
+		return t.root, t.err
+	}
+	t.root, t.err = t.state.tree.Root()
+	t.state.tree.tree = nil
+	t.pool.Put(t.state)
+	t.state = nil
+	return t.root, t.err
+}
@@ -0,0 +1,144 @@
+package wrapper_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTreePoolRoots checks that the roots computed with pooled trees are
+// identical to the ones computed with the standard constructor, when the trees
+// are reused across axes, square sizes and blocks.
+func TestTreePoolRoots(t *testing.T) {
+	pool := wrapper.NewTreePool()
+	for block := 0; block < 3; block++ {
+		for _, squareSize := range []int{1, 2, 4, 8, 16, 32} {
+			t.Run(fmt.Sprintf("block %d square size %d", block, squareSize), func(t *testing.T) {
+				data := testfactory.GenerateRandNamespacedRawData(squareSize * squareSize)
+				want := computeRoots(t, data, wrapper.NewConstructor(uint64(squareSize)))
+				got := computeRoots(t, data, pool.NewConstructor(uint64(squareSize)))
+				assert.Equal(t, want, got)
+			})
+		}
+	}
+}
+
+// TestTreePoolConcurrentSquares checks that squares extended concurrently with
+// the same pool have the same roots as with the standard constructor.
+func TestTreePoolConcurrentSquares(t *testing.T) {
+	pool := wrapper.NewTreePool()
+	squareSize := 16
+	squares := make([][][]byte, 8)
+	want := make([][][]byte, len(squares))
+	for i := range squares {
+		squares[i] = testfactory.GenerateRandNamespacedRawData(squareSize * squareSize)
+		want[i] = computeRoots(t, squares[i], wrapper.NewConstructor(uint64(squareSize)))
+	}
+
+	got := make([][][]byte, len(squares))
+	errs := make([]error, len(squares))
+	var wg sync.WaitGroup
+	for i := range squares {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			got[i], errs[i] = extendedDataSquareRoots(squares[i], pool.NewConstructor(uint64(squareSize)))
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestTreePoolPushErrors(t *testing.T) {
+	squareSize := 2
+	newTree := wrapper.NewTreePool().NewConstructor(uint64(squareSize))
+
+	tree := newTree(rsmt2d.Row, 0)
+	assert.Error(t, tree.Push([]byte{0x1}))
+
+	tree = newTree(rsmt2d.Row, 0)
+	for _, d := range generateErasuredData(t, squareSize, appconsts.DefaultCodec()) {
+		require.NoError(t, tree.Push(d))
+	}
+	assert.Error(t, tree.Push(generateErasuredData(t, 1, appconsts.DefaultCodec())[0]))
+}
+
+// TestTreePoolRootIdempotent checks that a pooled tree returns the same root
+// on every call to Root and rejects the shares pushed after it, even once its
+// state is reused by another tree.
+func TestTreePoolRootIdempotent(t *testing.T) {
+	squareSize := 2
+	newTree := wrapper.NewTreePool().NewConstructor(uint64(squareSize))
+	data := generateErasuredData(t, squareSize, appconsts.DefaultCodec())
+
+	tree := newTree(rsmt2d.Row, 0)
+	for _, d := range data {
+		require.NoError(t, tree.Push(d))
+	}
+	root, err := tree.Root()
+	require.NoError(t, err)
+
+	// reuse the state of the tree for another axis
+	other := newTree(rsmt2d.Col, 0)
+	for _, d := range data {
+		require.NoError(t, other.Push(d))
+	}
+
+	again, err := tree.Root()
+	require.NoError(t, err)
+	assert.Equal(t, root, again)
+	assert.Error(t, tree.Push(data[0]))
+}
+
+func BenchmarkExtendedDataSquareRoots(b *testing.B) {
+	for _, squareSize := range []int{8, 32, 64, 128} {
+		data := testfactory.GenerateRandNamespacedRawData(squareSize * squareSize)
+		b.Run(fmt.Sprintf("NewConstructor square size %d", squareSize), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				computeRoots(b, data, wrapper.NewConstructor(uint64(squareSize)))
+			}
+		})
+		b.Run(fmt.Sprintf("TreePool square size %d", squareSize), func(b *testing.B) {
+			pool := wrapper.NewTreePool()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				computeRoots(b, data, pool.NewConstructor(uint64(squareSize)))
+			}
+		})
+	}
+}
+
+// computeRoots extends the data and returns its row roots followed by its
+// column roots.
+func computeRoots(t testing.TB, data [][]byte, treeFn rsmt2d.TreeConstructorFn) [][]byte {
+	roots, err := extendedDataSquareRoots(data, treeFn)
+	require.NoError(t, err)
+	return roots
+}
+
+func extendedDataSquareRoots(data [][]byte, treeFn rsmt2d.TreeConstructorFn) ([][]byte, error) {
+	eds, err := rsmt2d.ComputeExtendedDataSquare(data, appconsts.DefaultCodec(), treeFn)
+	if err != nil {
+		return nil, err
+	}
+	rowRoots, err := eds.RowRoots()
+	if err != nil {
+		return nil, err
+	}
+	colRoots, err := eds.ColRoots()
+	if err != nil {
+		return nil, err
+	}
+	return append(rowRoots, colRoots...), nil
+}
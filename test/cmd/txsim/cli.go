@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -30,17 +31,24 @@ const (
 	TxsimKeypath       = "TXSIM_KEYPATH"
 	TxsimMasterAccName = "TXSIM_MASTER_ACC_NAME"
 	TxsimMnemonic      = "TXSIM_MNEMONIC"
+	TxsimScenario      = "TXSIM_SCENARIO"
 )
 
 // Values for all flags
 var (
 	keyPath, masterAccName, keyMnemonic, grpcEndpoint string
+	scenarioPath                                      string
 	blobSizes, blobAmounts                            string
 	seed                                              int64
 	pollTime                                          time.Duration
 	send, sendIterations, sendAmount                  int
 	stake, stakeValue, blob                           int
 	useFeegrant, suppressLogs                         bool
+	openLoopTPS, openLoopBPS                          float64
+	arrival                                           string
+	maxInFlight                                       int
+	openLoopDuration                                  time.Duration
+	metricsAddress, reportFile                        string
 )
 
 func main() {
@@ -61,8 +69,18 @@ func command() *cobra.Command {
 Txsim is a tool for randomized transaction generation on celestia networks. The tool relies on
 defined sequences; recursive patterns between one or more accounts which will continually submit
 transactions. You can use flags or environment variables (TXSIM_RPC, TXSIM_GRPC, TXSIM_SEED,
-TXSIM_POLL, TXSIM_KEYPATH) to configure the client. The keyring provided should have at least one
-well funded account that can act as the master account. The command runs until all sequences error.`,
+TXSIM_POLL, TXSIM_KEYPATH, TXSIM_SCENARIO) to configure the client. Sequences can also be described
+in a YAML or JSON scenario file (see txsim.Scenario) which sets the seed, poll time and feegrant
+usage unless they are set by flags. The keyring provided should have at least one well funded
+account that can act as the master account. The command runs until all sequences error.
+
+With --open-loop-tps or --open-loop-bps, txsim runs in open loop mode: operations are taken from
+the sequences in turn and submitted at the target rate, whether or not the previous ones have been
+committed, and a JSON report of the run is printed to stdout when it ends.
+
+The results of every transaction (latency, gas, fees, rejection codes and inclusion height) are
+collected and can be served as prometheus metrics with --metrics-address and written as a JSON
+report with --report-file.`,
 		Example: "txsim --key-path /path/to/keyring --grpc-endpoint localhost:9090 --seed 1234 --poll-time 1s --blob 5",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			var (
@@ -102,12 +120,36 @@ well funded account that can act as the master account. The command runs until a
 				masterAccName = os.Getenv(TxsimMasterAccName)
 			}
 
-			if stake == 0 && send == 0 && blob == 0 {
-				return errors.New("no sequences specified. Use --stake, --send or --blob")
+			if scenarioPath == "" {
+				scenarioPath = os.Getenv(TxsimScenario)
+			}
+
+			if stake == 0 && send == 0 && blob == 0 && scenarioPath == "" {
+				return errors.New("no sequences specified. Use --stake, --send, --blob or --scenario")
 			}
 
 			// setup the sequences
 			sequences := []txsim.Sequence{}
+			opts := txsim.DefaultOptions()
+
+			if scenarioPath != "" {
+				scenario, err := txsim.LoadScenario(scenarioPath)
+				if err != nil {
+					return err
+				}
+				scenarioSequences, err := scenario.BuildSequences()
+				if err != nil {
+					return err
+				}
+				sequences = append(sequences, scenarioSequences...)
+				opts = scenario.Options()
+				if scenario.Seed != 0 && seed == 0 {
+					seed = scenario.Seed
+				}
+				if !cmd.Flags().Changed("poll-time") && os.Getenv(TxsimPoll) == "" {
+					pollTime = time.Duration(scenario.PollTime)
+				}
+			}
 
 			if stake > 0 {
 				sequences = append(sequences, txsim.NewStakeSequence(stakeValue).Clone(stake)...)
@@ -150,9 +192,9 @@ well funded account that can act as the master account. The command runs until a
 				}
 			}
 
-			opts := txsim.DefaultOptions().
-				SpecifyMasterAccount(masterAccName).
-				WithSeed(seed)
+			opts.SpecifyMasterAccount(masterAccName).
+				WithSeed(seed).
+				WithPollTime(pollTime)
 
 			if useFeegrant {
 				opts.UseFeeGrant()
@@ -162,7 +204,32 @@ well funded account that can act as the master account. The command runs until a
 				opts.SuppressLogs()
 			}
 
+			if metricsAddress != "" {
+				opts.WithMetricsAddress(metricsAddress)
+			}
+
+			if reportFile != "" {
+				opts.WithReportFile(reportFile)
+			}
+
 			encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+			if openLoopTPS > 0 || openLoopBPS > 0 {
+				load := txsim.LoadConfig{
+					TPS:                    openLoopTPS,
+					BytesPerSecond:         openLoopBPS,
+					Arrival:                txsim.Arrival(arrival),
+					MaxInFlightPerSequence: maxInFlight,
+					Duration:               openLoopDuration,
+				}
+				report, err := txsim.RunOpenLoop(cmd.Context(), grpcEndpoint, keys, encCfg, opts, load, sequences...)
+				if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+					return err
+				}
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(report)
+			}
+
 			err = txsim.Run(
 				cmd.Context(),
 				grpcEndpoint,
@@ -188,6 +255,7 @@ func flags() *flag.FlagSet {
 	flags.StringVar(&masterAccName, "master", "", "the account name of the master account. Leaving empty will result in using the account with the most funds.")
 	flags.StringVar(&keyMnemonic, "key-mnemonic", "", "space separated mnemonic for the keyring. The hdpath used is an empty string")
 	flags.StringVar(&grpcEndpoint, "grpc-endpoint", "", "grpc endpoint to a running node")
+	flags.StringVar(&scenarioPath, "scenario", "", "path to a YAML or JSON scenario file describing the sequences to run")
 	flags.Int64Var(&seed, "seed", 0, "seed for the random number generator")
 	flags.DurationVar(&pollTime, "poll-time", user.DefaultPollTime, "poll time for the transaction client")
 	flags.IntVar(&send, "send", 0, "number of send sequences to run")
@@ -200,6 +268,13 @@ func flags() *flag.FlagSet {
 	flags.StringVar(&blobAmounts, "blob-amounts", "1", "range of blobs per PFB specified as a single value or a min-max range (e.g., 10 or 5-10). A single value indicates the exact number of blobs to be created.")
 	flags.BoolVar(&useFeegrant, "feegrant", false, "use the feegrant module to pay for fees")
 	flags.BoolVar(&suppressLogs, "suppressLogs", false, "disable logging")
+	flags.Float64Var(&openLoopTPS, "open-loop-tps", 0, "run in open loop mode, submitting this many transactions per second")
+	flags.Float64Var(&openLoopBPS, "open-loop-bps", 0, "run in open loop mode, submitting this many bytes per second")
+	flags.StringVar(&arrival, "arrival", string(txsim.ArrivalConstant), "distribution of the transaction arrivals in open loop mode: constant or poisson")
+	flags.IntVar(&maxInFlight, "max-in-flight", txsim.DefaultMaxInFlightPerSequence, "maximum number of uncommitted transactions per blob sequence in open loop mode, the other sequences submit one transaction at a time")
+	flags.StringVar(&metricsAddress, "metrics-address", "", "address to serve the prometheus metrics (/metrics) and the JSON report (/report) of the run on, e.g. :9464")
+	flags.StringVar(&reportFile, "report-file", "", "path of the file to write the JSON report of the run to when it ends")
+	flags.DurationVar(&openLoopDuration, "duration", 0, "duration of an open loop run, runs until interrupted if zero")
 	return flags
 }
 
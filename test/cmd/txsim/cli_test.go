@@ -1,14 +1,18 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/app"
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/test/txsim"
 	"github.com/celestiaorg/celestia-app/v2/test/util/genesis"
 	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
@@ -24,14 +28,17 @@ func TestTxsimCommandFlags(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	reportFile := filepath.Join(t.TempDir(), "report.json")
 	cmd.SetArgs([]string{
 		"--key-mnemonic", testfactory.TestAccMnemo,
 		"--grpc-endpoint", grpcAddr,
 		"--blob", "5",
 		"--seed", "1234",
+		"--report-file", reportFile,
 	})
 	err := cmd.ExecuteContext(ctx)
 	require.NoError(t, err)
+	require.FileExists(t, reportFile)
 }
 
 func TestTxsimCommandEnvVar(t *testing.T) {
@@ -52,6 +59,56 @@ func TestTxsimCommandEnvVar(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTxsimCommandOpenLoop(t *testing.T) {
+	_, _, grpcAddr := setup(t)
+	cmd := command()
+	var output bytes.Buffer
+	cmd.SetOut(&output)
+
+	cmd.SetArgs([]string{
+		"--key-mnemonic", testfactory.TestAccMnemo,
+		"--grpc-endpoint", grpcAddr,
+		"--blob", "2",
+		"--seed", "1234",
+		"--open-loop-tps", "2",
+		"--arrival", "poisson",
+		"--duration", "5s",
+	})
+	err := cmd.ExecuteContext(context.Background())
+	require.NoError(t, err)
+
+	var report txsim.LoadReport
+	require.NoError(t, json.Unmarshal(output.Bytes(), &report))
+	require.Greater(t, report.Scheduled, 0)
+	require.Equal(t, 2.0, report.TargetTPS)
+}
+
+func TestTxsimCommandScenario(t *testing.T) {
+	_, _, grpcAddr := setup(t)
+	cmd := command()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	scenario := filepath.Join(t.TempDir(), "scenario.yaml")
+	require.NoError(t, os.WriteFile(scenario, []byte(`
+seed: 1234
+sequences:
+  - type: blob
+    count: 2
+    blob_sizes: 100-1000
+  - type: send
+    rate: 1
+`), 0o600))
+	cmd.SetArgs([]string{
+		"--key-mnemonic", testfactory.TestAccMnemo,
+		"--grpc-endpoint", grpcAddr,
+		"--scenario", scenario,
+	})
+	err := cmd.ExecuteContext(ctx)
+	require.NoError(t, err)
+}
+
 func setup(t testing.TB) (keyring.Keyring, string, string) {
 	if testing.Short() {
 		t.Skip("skipping tx sim in short mode.")
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/test/e2e/testnet"
+	"github.com/celestiaorg/celestia-app/v2/test/txsim"
 	"github.com/tendermint/tendermint/pkg/trace"
 )
 
@@ -15,6 +18,13 @@ type BenchmarkTest struct {
 }
 
 func NewBenchmarkTest(name string, manifest *Manifest) (*BenchmarkTest, error) {
+	// fail early on an invalid scenario rather than in the tx clients
+	if manifest.TxsimScenario != "" {
+		if _, err := txsim.LoadScenario(manifest.TxsimScenario); err != nil {
+			return nil, err
+		}
+	}
+
 	// create a new testnet
 	testNet, err := testnet.New(name, seed,
 		testnet.GetGrafanaInfoFromEnvVar(), manifest.ChainID,
@@ -56,6 +66,8 @@ func (b *BenchmarkTest) SetupNodes() error {
 		b.manifest.BlobSequences,
 		b.manifest.BlobSizes,
 		b.manifest.BlobsPerSeq,
+		b.manifest.TxsimScenario,
+		b.manifest.TxsimReportFile != "",
 		b.manifest.TxClientsResource, gRPCEndpoints)
 	testnet.NoError("failed to create tx clients", err)
 
@@ -125,5 +137,30 @@ func (b *BenchmarkTest) Run() error {
 	// wait some time for the tx clients to submit transactions
 	time.Sleep(b.manifest.TestDuration)
 
+	if b.manifest.TxsimReportFile != "" {
+		log.Println("Collecting tx client reports")
+		if err := b.writeTxClientReports(); err != nil {
+			return fmt.Errorf("failed to collect tx client reports: %v", err)
+		}
+	}
+
 	return nil
 }
+
+// writeTxClientReports writes the reports of the tx clients to the report
+// file of the manifest.
+func (b *BenchmarkTest) writeTxClientReports() error {
+	reports, err := b.TxClientReports()
+	if err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return err
+	}
+	for name, report := range reports {
+		log.Printf("%s: %d operations, %d committed, %d rejected, p50 latency %s, p99 latency %s",
+			name, report.Operations, report.Committed, report.Rejected, report.Latency.P50, report.Latency.P99)
+	}
+	return os.WriteFile(b.manifest.TxsimReportFile, bz, 0o644)
+}
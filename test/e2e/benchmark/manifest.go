@@ -49,6 +49,16 @@ type Manifest struct {
 	BlobSequences int
 	// Size of blobs in bytes, e.g., "10000" (exact size) or "10000-20000" (min-max format)
 	BlobSizes string
+	// TxsimScenario is the path to a txsim scenario file (see txsim.Scenario)
+	// run by every tx client in addition to the blob sequences. It requires a
+	// TxClientVersion that supports scenarios.
+	TxsimScenario string
+	// TxsimReportFile is the path of the file the reports of the tx clients
+	// (see txsim.Report) are written to at the end of the test, as a JSON
+	// object by tx client name, to compare benchmark runs. If empty, no
+	// report is collected. It requires a TxClientVersion that supports
+	// reports.
+	TxsimReportFile string
 
 	// p2p configs
 	// Bandwidth per peer in bytes per second
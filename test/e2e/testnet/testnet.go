@@ -9,6 +9,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v2/app"
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/celestiaorg/celestia-app/v2/test/txsim"
 	"github.com/celestiaorg/celestia-app/v2/test/util/genesis"
 	"github.com/celestiaorg/knuu/pkg/knuu"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -95,13 +96,15 @@ func (t *Testnet) CreateTxClients(version string,
 	sequences int,
 	blobRange string,
 	blobPerSequence int,
+	scenario string,
+	metrics bool,
 	resources Resources,
 	grpcEndpoints []string,
 ) error {
 	for i, grpcEndpoint := range grpcEndpoints {
 		name := fmt.Sprintf("txsim%d", i)
 		err := t.CreateTxClient(name, version, sequences,
-			blobRange, blobPerSequence, resources, grpcEndpoint)
+			blobRange, blobPerSequence, scenario, metrics, resources, grpcEndpoint)
 		if err != nil {
 			log.Err(err).Str("name", name).
 				Str("grpc endpoint", grpcEndpoint).
@@ -123,6 +126,9 @@ func (t *Testnet) CreateTxClients(version string,
 // seed: seed for the txsim
 // sequences: number of sequences to be run by the txsim
 // blobRange: range of blob sizes to be used by the txsim in bytes
+// scenario: path to a txsim scenario file run in addition to the blob
+// sequences, or empty
+// metrics: serve the metrics and report of the txsim, see TxSim.Report
 // pollTime: time in seconds between each sequence
 // resources: resources to be allocated to the txsim
 // grpcEndpoint: grpc endpoint of the node to which the txsim will connect and send transactions
@@ -131,6 +137,8 @@ func (t *Testnet) CreateTxClient(name,
 	sequences int,
 	blobRange string,
 	blobPerSequence int,
+	scenario string,
+	metrics bool,
 	resources Resources,
 	grpcEndpoint string,
 ) error {
@@ -148,7 +156,7 @@ func (t *Testnet) CreateTxClient(name,
 
 	// Create a txsim node using the key stored in the txsimKeyringDir
 	txsim, err := CreateTxClient(name, version, grpcEndpoint, t.seed,
-		sequences, blobRange, blobPerSequence, 1, resources, txsimRootDir)
+		sequences, blobRange, blobPerSequence, scenario, metrics, 1, resources, txsimRootDir)
 	if err != nil {
 		log.Err(err).
 			Str("name", name).
@@ -178,6 +186,20 @@ func (t *Testnet) CreateTxClient(name,
 	return nil
 }
 
+// TxClientReports fetches the reports of the runs of the tx clients by name.
+// The tx clients must have been created with metrics enabled.
+func (t *Testnet) TxClientReports() (map[string]txsim.Report, error) {
+	reports := make(map[string]txsim.Report, len(t.txClients))
+	for _, client := range t.txClients {
+		report, err := client.Report()
+		if err != nil {
+			return nil, fmt.Errorf("fetching the report of %s: %w", client.Name, err)
+		}
+		reports[client.Name] = report
+	}
+	return reports, nil
+}
+
 func (t *Testnet) StartTxClients() error {
 	for _, txsim := range t.txClients {
 		err := txsim.Instance.Start()
@@ -1,14 +1,19 @@
 package testnet
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"path/filepath"
 
+	"github.com/celestiaorg/celestia-app/v2/test/txsim"
 	"github.com/celestiaorg/knuu/pkg/knuu"
 	"github.com/rs/zerolog/log"
 )
 
 const (
 	txsimDockerSrcURL = "ghcr.io/celestiaorg/txsim"
+	txsimMetricsPort  = 9464
 )
 
 func txsimDockerImageName(version string) string {
@@ -27,6 +32,8 @@ func CreateTxClient(
 	sequences int,
 	blobRange string,
 	blobsPerSeq int,
+	scenario string,
+	metrics bool,
 	pollTime int,
 	resources Resources,
 	volumePath string,
@@ -69,6 +76,22 @@ func CreateTxClient(
 		fmt.Sprintf("-a %d ", blobsPerSeq),
 		fmt.Sprintf("-s %s ", blobRange),
 	}
+	if scenario != "" {
+		scenarioPath := filepath.Join(volumePath, "scenario"+filepath.Ext(scenario))
+		err = instance.AddFile(scenario, scenarioPath, "10001:10001")
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, fmt.Sprintf("-f %s", scenarioPath))
+	}
+
+	if metrics {
+		err = instance.AddPortTCP(txsimMetricsPort)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, fmt.Sprintf("-x :%d", txsimMetricsPort))
+	}
 
 	err = instance.SetArgs(args...)
 	if err != nil {
@@ -80,3 +103,25 @@ func CreateTxClient(
 		Instance: instance,
 	}, nil
 }
+
+// Report fetches the report of the run of the txsim so far. The txsim must
+// have been created with metrics enabled.
+func (ts *TxSim) Report() (txsim.Report, error) {
+	port, err := ts.Instance.PortForwardTCP(txsimMetricsPort)
+	if err != nil {
+		return txsim.Report{}, fmt.Errorf("forwarding port %d: %w", txsimMetricsPort, err)
+	}
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/report", port))
+	if err != nil {
+		return txsim.Report{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return txsim.Report{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	var report txsim.Report
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return txsim.Report{}, err
+	}
+	return report, nil
+}
@@ -41,6 +41,7 @@ type AccountManager struct {
 	lastUpdated  time.Time
 	accountIndex int
 	addressMap   map[string]string
+	results      *Collector
 }
 
 func NewAccountManager(
@@ -70,6 +71,7 @@ func NewAccountManager(
 		useFeegrant:  useFeegrant,
 		addressMap:   make(map[string]string),
 		accountIndex: len(records),
+		results:      NewCollector(),
 	}
 
 	if masterAccName == "" {
@@ -193,26 +195,9 @@ func (am *AccountManager) AllocateAccounts(n, balance int) []types.AccAddress {
 
 // Submit executes on an operation. This is thread safe.
 func (am *AccountManager) Submit(ctx context.Context, op Operation) error {
-	if len(op.Msgs) == 0 {
-		return errors.New("operation must contain at least one message")
-	}
-
-	var address types.AccAddress
-	for _, msg := range op.Msgs {
-		if err := msg.ValidateBasic(); err != nil {
-			return fmt.Errorf("error validating message: %w", err)
-		}
-
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return fmt.Errorf("only a single signer is supported got: %d", len(signers))
-		}
-
-		if address == nil {
-			address = signers[0]
-		} else if !address.Equals(signers[0]) {
-			return fmt.Errorf("all messages must be signed by the same account")
-		}
+	address, err := validateOperation(op)
+	if err != nil {
+		return err
 	}
 
 	// If a delay is set, wait for that many blocks to have been produced
@@ -223,67 +208,34 @@ func (am *AccountManager) Submit(ctx context.Context, op Operation) error {
 		}
 	}
 
-	opts := make([]user.TxOption, 0)
-	if op.GasLimit == 0 {
-		opts = append(opts, user.SetGasLimit(DefaultGasLimit), user.SetFee(defaultFee))
-	} else {
-		opts = append(opts, user.SetGasLimit(op.GasLimit))
-		if op.GasPrice > 0 {
-			opts = append(opts, user.SetFee(uint64(math.Ceil(float64(op.GasLimit)*op.GasPrice))))
-		} else {
-			opts = append(opts, user.SetFee(uint64(math.Ceil(float64(op.GasLimit)*appconsts.DefaultMinGasPrice))))
-		}
-	}
-
-	if am.useFeegrant {
-		opts = append(opts, user.SetFeeGranter(am.txClient.DefaultAddress()))
-	}
-
-	var (
-		res *types.TxResponse
-		err error
-	)
-	if len(op.Blobs) > 0 {
-		accName, ok := am.addressMap[address.String()]
-		if !ok {
-			return fmt.Errorf("account not found for address %s", address.String())
-		}
-		res, err = am.txClient.SubmitPayForBlobsWithAccount(ctx, accName, op.Blobs, opts...)
-		if err != nil {
-			// log the failed tx
+	result, err := am.execute(ctx, address, op)
+	if err != nil {
+		// log the failed tx
+		if len(op.Blobs) > 0 {
 			log.Err(err).
 				Str("address", address.String()).
 				Str("blobs count", fmt.Sprintf("%d", len(op.Blobs))).
 				Int64("total byte size of blobs", getSize(op.Blobs)).
 				Msg("tx failed")
-		}
-	} else {
-		res, err = am.txClient.SubmitTx(ctx, op.Msgs, opts...)
-		// log the failed tx
-		if err != nil {
+		} else {
 			log.Err(err).
 				Str("address", address.String()).
 				Str("msgs", msgsToString(op.Msgs)).
 				Msg("tx failed")
 		}
-	}
-	if err != nil {
 		return err
 	}
 
-	// update the latest latestHeight
-	am.setLatestHeight(res.Height)
-
 	if len(op.Blobs) > 0 {
 		log.Info().
-			Int64("height", res.Height).
+			Int64("height", result.Height).
 			Str("address", address.String()).
 			Str("blobs count", fmt.Sprintf("%d", len(op.Blobs))).
 			Int64("total byte size of blobs", getSize(op.Blobs)).
 			Msg("tx committed")
 	} else {
 		log.Info().
-			Int64("height", res.Height).
+			Int64("height", result.Height).
 			Str("address", address.String()).
 			Str("msgs", msgsToString(op.Msgs)).
 			Msg("tx committed")
@@ -292,6 +244,93 @@ func (am *AccountManager) Submit(ctx context.Context, op Operation) error {
 	return nil
 }
 
+// validateOperation checks the messages of the operation and returns the
+// address of the account signing them.
+func validateOperation(op Operation) (types.AccAddress, error) {
+	if len(op.Msgs) == 0 {
+		return nil, errors.New("operation must contain at least one message")
+	}
+
+	var address types.AccAddress
+	for _, msg := range op.Msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("error validating message: %w", err)
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, fmt.Errorf("only a single signer is supported got: %d", len(signers))
+		}
+
+		if address == nil {
+			address = signers[0]
+		} else if !address.Equals(signers[0]) {
+			return nil, fmt.Errorf("all messages must be signed by the same account")
+		}
+	}
+	return address, nil
+}
+
+// Results returns the collector of the results of the submitted operations.
+func (am *AccountManager) Results() *Collector {
+	return am.results
+}
+
+// execute broadcasts the operation, waits for it to be committed and records
+// its result. This is thread safe.
+func (am *AccountManager) execute(ctx context.Context, address types.AccAddress, op Operation) (OperationResult, error) {
+	result := newOperationResult(address, op)
+	start := time.Now()
+	res, err := am.broadcast(ctx, address, op)
+	result.update(res, err)
+	if err == nil {
+		result.Accepted = true
+		res, err = am.txClient.ConfirmTx(ctx, res.TxHash)
+		result.update(res, err)
+	}
+	if err == nil {
+		result.Latency = time.Since(start)
+		// update the latest latestHeight
+		am.setLatestHeight(res.Height)
+	}
+	am.results.Record(result)
+	return result, err
+}
+
+// operationGas returns the gas limit and the fee of the operation.
+func operationGas(op Operation) (gasLimit, fee uint64) {
+	if op.GasLimit == 0 {
+		return DefaultGasLimit, defaultFee
+	}
+	if op.GasPrice > 0 {
+		return op.GasLimit, uint64(math.Ceil(float64(op.GasLimit) * op.GasPrice))
+	}
+	return op.GasLimit, uint64(math.Ceil(float64(op.GasLimit) * appconsts.DefaultMinGasPrice))
+}
+
+// broadcast signs and broadcasts the transaction of the operation without
+// waiting for it to be committed. This is thread safe.
+func (am *AccountManager) broadcast(ctx context.Context, address types.AccAddress, op Operation) (*types.TxResponse, error) {
+	gasLimit, fee := operationGas(op)
+	opts := []user.TxOption{user.SetGasLimit(gasLimit), user.SetFee(fee)}
+
+	switch {
+	case op.FeeGranter != nil:
+		opts = append(opts, user.SetFeeGranter(op.FeeGranter))
+	case am.useFeegrant:
+		opts = append(opts, user.SetFeeGranter(am.txClient.DefaultAddress()))
+	}
+
+	if len(op.Blobs) > 0 {
+		accName, ok := am.addressMap[address.String()]
+		if !ok {
+			return nil, fmt.Errorf("account not found for address %s", address.String())
+		}
+		return am.txClient.BroadcastPayForBlobWithAccount(ctx, accName, op.Blobs, opts...)
+	}
+	return am.txClient.BroadcastTx(ctx, op.Msgs, opts...)
+}
+
 func getSize(blobs []*blob.Blob) int64 {
 	size := int64(0)
 	for _, blob := range blobs {
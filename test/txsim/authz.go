@@ -0,0 +1,90 @@
+package txsim
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/grpc"
+)
+
+var _ Sequence = &AuthzSequence{}
+
+// AuthzSequence sets up a sequence whereby an account authorizes a set of accounts to send its tokens, which
+// they then do by executing sends on its behalf. The grantees alternate between a send authorization, limited
+// to the tokens sent throughout the sequence, and a generic authorization for bank sends.
+type AuthzSequence struct {
+	numGrantees   int
+	sendAmount    int
+	numIterations int
+
+	granter  types.AccAddress
+	grantees []types.AccAddress
+	granted  bool
+	index    int
+}
+
+func NewAuthzSequence(numGrantees, sendAmount, numIterations int) *AuthzSequence {
+	return &AuthzSequence{
+		numGrantees:   numGrantees,
+		sendAmount:    sendAmount,
+		numIterations: numIterations,
+	}
+}
+
+func (s *AuthzSequence) Clone(n int) []Sequence {
+	sequenceGroup := make([]Sequence, n)
+	for i := 0; i < n; i++ {
+		sequenceGroup[i] = NewAuthzSequence(s.numGrantees, s.sendAmount, s.numIterations)
+	}
+	return sequenceGroup
+}
+
+// Init funds the granter with the tokens sent by the grantees and the grantees
+// with the fees of the executions.
+func (s *AuthzSequence) Init(_ context.Context, _ grpc.ClientConn, allocateAccounts AccountAllocator, _ *rand.Rand, useFeegrant bool) {
+	funds := fundsForGas
+	if useFeegrant {
+		funds = 1
+	}
+	s.granter = allocateAccounts(1, s.numIterations*s.sendAmount+funds)[0]
+	s.grantees = allocateAccounts(s.numGrantees, funds)
+}
+
+func (s *AuthzSequence) Next(_ context.Context, _ grpc.ClientConn, rand *rand.Rand) (Operation, error) {
+	// for the first operation, the granter authorizes all grantees
+	if !s.granted {
+		msgs := make([]types.Msg, len(s.grantees))
+		for i, grantee := range s.grantees {
+			var authorization authz.Authorization
+			if i%2 == 0 {
+				authorization = bank.NewSendAuthorization(types.NewCoins(types.NewInt64Coin(appconsts.BondDenom, int64(s.numIterations*s.sendAmount))))
+			} else {
+				authorization = authz.NewGenericAuthorization(types.MsgTypeURL(&bank.MsgSend{}))
+			}
+			msg, err := authz.NewMsgGrant(s.granter, grantee, authorization, nil)
+			if err != nil {
+				return Operation{}, err
+			}
+			msgs[i] = msg
+		}
+		s.granted = true
+		return Operation{Msgs: msgs}, nil
+	}
+
+	if s.index >= s.numIterations {
+		return Operation{}, ErrEndOfSequence
+	}
+	grantee := s.grantees[rand.Intn(len(s.grantees))]
+	exec := authz.NewMsgExec(grantee, []types.Msg{
+		bank.NewMsgSend(s.granter, grantee, types.NewCoins(types.NewInt64Coin(appconsts.BondDenom, int64(s.sendAmount)))),
+	})
+	s.index++
+	return Operation{
+		Msgs:  []types.Msg{&exec},
+		Delay: uint64(rand.Int63n(3)),
+	}, nil
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/gogo/protobuf/grpc"
 )
 
-var _ Sequence = &BlobSequence{}
+var _ IndependentSequence = &BlobSequence{}
 
 // As napkin math, this would cover the cost of 8267 4KB blobs
 const fundsForGas int = 1e9 // 1000 TIA
@@ -64,6 +64,10 @@ func (s *BlobSequence) Init(_ context.Context, _ grpc.ClientConn, allocateAccoun
 	s.account = allocateAccounts(1, funds)[0]
 }
 
+// IndependentOperations returns true as every PFB is paid for by the account
+// of the sequence and doesn't depend on the previous ones.
+func (s *BlobSequence) IndependentOperations() bool { return true }
+
 func (s *BlobSequence) Next(_ context.Context, _ grpc.ClientConn, rand *rand.Rand) (Operation, error) {
 	numBlobs := s.blobsPerPFB.Rand(rand)
 	sizes := make([]int, numBlobs)
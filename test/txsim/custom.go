@@ -0,0 +1,87 @@
+package txsim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/grpc"
+)
+
+var _ Sequence = &CustomSequence{}
+
+// CustomSequenceAccount is the placeholder replaced by the address of the
+// account of a CustomSequence in its message templates.
+const CustomSequenceAccount = "$account"
+
+// CustomSequence sets up an endless sequence whereby a single account
+// repeatedly submits the same messages. The messages are provided as JSON
+// encoded templates of sdk messages, with their type URL set in the "@type"
+// field, in which every occurrence of CustomSequenceAccount is replaced by the
+// address of the account of the sequence, e.g.:
+//
+//	{"@type": "/cosmos.bank.v1beta1.MsgSend", "from_address": "$account", ...}
+type CustomSequence struct {
+	templates []json.RawMessage
+	balance   int
+	gasLimit  uint64
+
+	account types.AccAddress
+	msgs    []types.Msg
+}
+
+// NewCustomSequence returns a sequence submitting the messages of the
+// templates, from an account funded with balance. If gasLimit is zero, the
+// DefaultGasLimit is used.
+func NewCustomSequence(balance int, gasLimit uint64, templates ...json.RawMessage) *CustomSequence {
+	return &CustomSequence{
+		templates: templates,
+		balance:   balance,
+		gasLimit:  gasLimit,
+	}
+}
+
+func (s *CustomSequence) Clone(n int) []Sequence {
+	sequenceGroup := make([]Sequence, n)
+	for i := 0; i < n; i++ {
+		sequenceGroup[i] = NewCustomSequence(s.balance, s.gasLimit, s.templates...)
+	}
+	return sequenceGroup
+}
+
+func (s *CustomSequence) Init(_ context.Context, _ grpc.ClientConn, allocateAccounts AccountAllocator, _ *rand.Rand, _ bool) {
+	s.account = allocateAccounts(1, s.balance)[0]
+}
+
+func (s *CustomSequence) Next(_ context.Context, _ grpc.ClientConn, _ *rand.Rand) (Operation, error) {
+	if s.msgs == nil {
+		msgs, err := decodeMsgTemplates(s.templates, s.account.String())
+		if err != nil {
+			return Operation{}, err
+		}
+		s.msgs = msgs
+	}
+	return Operation{
+		Msgs:     s.msgs,
+		GasLimit: s.gasLimit,
+	}, nil
+}
+
+// decodeMsgTemplates decodes the JSON message templates after replacing the
+// account placeholder by the address.
+func decodeMsgTemplates(templates []json.RawMessage, address string) ([]types.Msg, error) {
+	cdc := encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec
+	msgs := make([]types.Msg, len(templates))
+	for i, template := range templates {
+		bz := bytes.ReplaceAll(template, []byte(CustomSequenceAccount), []byte(address))
+		if err := cdc.UnmarshalInterfaceJSON(bz, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("decoding message %d: %w", i, err)
+		}
+	}
+	return msgs, nil
+}
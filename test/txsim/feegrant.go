@@ -0,0 +1,97 @@
+package txsim
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/gogo/protobuf/grpc"
+)
+
+var _ Sequence = &FeegrantSequence{}
+
+// FeegrantSequence sets up a sequence whereby an account grants a fee allowance to a set of accounts which then
+// send tokens back to the granter, paying the fees through the allowance. Occasionally, the granter revokes the
+// allowance of a grantee and grants it again.
+type FeegrantSequence struct {
+	numGrantees       int
+	sendAmount        int
+	numIterations     int
+	revokeProbability int
+
+	granter  types.AccAddress
+	grantees []types.AccAddress
+	granted  bool
+	index    int
+}
+
+func NewFeegrantSequence(numGrantees, sendAmount, numIterations int) *FeegrantSequence {
+	return &FeegrantSequence{
+		numGrantees:       numGrantees,
+		sendAmount:        sendAmount,
+		numIterations:     numIterations,
+		revokeProbability: 10, // 1 in every 10
+	}
+}
+
+func (s *FeegrantSequence) Clone(n int) []Sequence {
+	sequenceGroup := make([]Sequence, n)
+	for i := 0; i < n; i++ {
+		sequenceGroup[i] = NewFeegrantSequence(s.numGrantees, s.sendAmount, s.numIterations)
+	}
+	return sequenceGroup
+}
+
+// Init funds the granter with the fees of the grantees. The grantees only
+// hold the tokens they send.
+func (s *FeegrantSequence) Init(_ context.Context, _ grpc.ClientConn, allocateAccounts AccountAllocator, _ *rand.Rand, useFeegrant bool) {
+	funds := fundsForGas
+	if useFeegrant {
+		funds = 1
+	}
+	s.granter = allocateAccounts(1, s.numIterations*defaultFee+funds)[0]
+	s.grantees = allocateAccounts(s.numGrantees, s.numIterations*s.sendAmount)
+}
+
+func (s *FeegrantSequence) Next(_ context.Context, _ grpc.ClientConn, rand *rand.Rand) (Operation, error) {
+	// for the first operation, the granter grants an allowance to all grantees
+	if !s.granted {
+		msgs := make([]types.Msg, len(s.grantees))
+		for i, grantee := range s.grantees {
+			msg, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{}, s.granter, grantee)
+			if err != nil {
+				return Operation{}, err
+			}
+			msgs[i] = msg
+		}
+		s.granted = true
+		return Operation{Msgs: msgs}, nil
+	}
+
+	if s.index >= s.numIterations {
+		return Operation{}, ErrEndOfSequence
+	}
+	grantee := s.grantees[rand.Intn(len(s.grantees))]
+
+	// occasionally revoke the allowance of a grantee and grant it again
+	if rand.Intn(s.revokeProbability) == 0 {
+		revoke := feegrant.NewMsgRevokeAllowance(s.granter, grantee)
+		grant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{}, s.granter, grantee)
+		if err != nil {
+			return Operation{}, err
+		}
+		return Operation{Msgs: []types.Msg{&revoke, grant}}, nil
+	}
+
+	s.index++
+	return Operation{
+		Msgs: []types.Msg{
+			bank.NewMsgSend(grantee, s.granter, types.NewCoins(types.NewInt64Coin(appconsts.BondDenom, int64(s.sendAmount)))),
+		},
+		Delay:      uint64(rand.Int63n(3)),
+		FeeGranter: s.granter,
+	}, nil
+}
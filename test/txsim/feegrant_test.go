@@ -0,0 +1,56 @@
+package txsim
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testAllocator returns an AccountAllocator of random addresses.
+func testAllocator(r *rand.Rand) AccountAllocator {
+	return func(n, _ int) []types.AccAddress {
+		addresses := make([]types.AccAddress, n)
+		for i := range addresses {
+			addresses[i] = make(types.AccAddress, 20)
+			_, _ = r.Read(addresses[i])
+		}
+		return addresses
+	}
+}
+
+func TestFeegrantSequence(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sequence := NewFeegrantSequence(2, 10, 20)
+	sequence.Init(context.Background(), nil, testAllocator(r), r, false)
+
+	op, err := sequence.Next(context.Background(), nil, r)
+	require.NoError(t, err)
+	require.Len(t, op.Msgs, 2)
+	for _, msg := range op.Msgs {
+		assert.IsType(t, &feegrant.MsgGrantAllowance{}, msg)
+	}
+
+	sends := 0
+	for {
+		op, err := sequence.Next(context.Background(), nil, r)
+		if err == ErrEndOfSequence {
+			break
+		}
+		require.NoError(t, err)
+		if len(op.Msgs) == 2 {
+			// revoke and grant again
+			assert.IsType(t, &feegrant.MsgRevokeAllowance{}, op.Msgs[0])
+			assert.Nil(t, op.FeeGranter)
+			continue
+		}
+		assert.Equal(t, sequence.granter, op.FeeGranter)
+		assert.NotEqual(t, sequence.granter, op.Msgs[0].GetSigners()[0])
+		sends++
+	}
+	assert.Equal(t, 20, sends)
+}
@@ -0,0 +1,152 @@
+package txsim
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/gogo/protobuf/grpc"
+)
+
+var _ Sequence = &GovSequence{}
+
+const proposalGasLimit = 300_000
+
+// voteOptions are the options that voters choose from at random.
+var voteOptions = []govv1.VoteOption{
+	govv1.OptionYes,
+	govv1.OptionAbstain,
+	govv1.OptionNo,
+	govv1.OptionNoWithVeto,
+}
+
+// GovSequence sets up a sequence whereby an account submits governance proposals with the full deposit and a
+// set of accounts vote on each proposal with a random option. The proposals are never executed as the voting
+// period outlasts the sequence.
+type GovSequence struct {
+	numVoters    int
+	numProposals int
+	// deposit is the minimum deposit of the chain, queried in Init. Proposals
+	// with this deposit enter the voting period directly.
+	deposit int
+
+	proposer types.AccAddress
+	voters   []types.AccAddress
+
+	submitted int
+	// lookup is set when the last submitted proposal is yet to be looked up
+	// to get the ID of the proposal to vote on.
+	lookup     bool
+	proposalID uint64
+	voteIndex  int
+}
+
+func NewGovSequence(numVoters, numProposals int) *GovSequence {
+	return &GovSequence{
+		numVoters:    numVoters,
+		numProposals: numProposals,
+	}
+}
+
+func (s *GovSequence) Clone(n int) []Sequence {
+	sequenceGroup := make([]Sequence, n)
+	for i := 0; i < n; i++ {
+		sequenceGroup[i] = NewGovSequence(s.numVoters, s.numProposals)
+	}
+	return sequenceGroup
+}
+
+// Init queries the minimum deposit of the chain and funds the proposer with the
+// deposits of all the proposals.
+func (s *GovSequence) Init(ctx context.Context, querier grpc.ClientConn, allocateAccounts AccountAllocator, _ *rand.Rand, useFeegrant bool) {
+	resp, err := govv1.NewQueryClient(querier).Params(ctx, &govv1.QueryParamsRequest{ParamsType: govv1.ParamDeposit})
+	if err != nil {
+		panic(fmt.Errorf("querying the gov deposit params: %w", err))
+	}
+	if resp.DepositParams == nil {
+		panic("the gov deposit params are missing")
+	}
+	s.deposit = int(types.Coins(resp.DepositParams.MinDeposit).AmountOf(appconsts.BondDenom).Int64())
+
+	funds := fundsForGas
+	if useFeegrant {
+		funds = 1
+	}
+	s.proposer = allocateAccounts(1, s.deposit*s.numProposals+funds)[0]
+	s.voters = allocateAccounts(s.numVoters, funds)
+}
+
+// Next submits a proposal, then a vote from each of the voters on it, until
+// all the proposals have been voted on.
+func (s *GovSequence) Next(ctx context.Context, querier grpc.ClientConn, rand *rand.Rand) (Operation, error) {
+	if s.lookup {
+		id, err := s.findProposal(ctx, querier)
+		if err != nil {
+			return Operation{}, err
+		}
+		s.proposalID = id
+		s.voteIndex = 0
+		s.lookup = false
+	}
+
+	if s.proposalID != 0 && s.voteIndex < len(s.voters) {
+		op := Operation{
+			Msgs: []types.Msg{
+				govv1.NewMsgVote(s.voters[s.voteIndex], s.proposalID, voteOptions[rand.Intn(len(voteOptions))], ""),
+			},
+			Delay: uint64(rand.Int63n(3)),
+		}
+		s.voteIndex++
+		return op, nil
+	}
+
+	if s.submitted >= s.numProposals {
+		return Operation{}, ErrEndOfSequence
+	}
+	// the proposal sends funds from the gov module account, the authority of
+	// the proposal messages, back to the proposer
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msg, err := govv1.NewMsgSubmitProposal(
+		[]types.Msg{bank.NewMsgSend(authority, s.proposer, types.NewCoins(types.NewInt64Coin(appconsts.BondDenom, 1)))},
+		types.NewCoins(types.NewInt64Coin(appconsts.BondDenom, int64(s.deposit))),
+		s.proposer.String(),
+		fmt.Sprintf("txsim proposal %d", s.submitted),
+	)
+	if err != nil {
+		return Operation{}, err
+	}
+	s.submitted++
+	s.lookup = true
+	return Operation{
+		Msgs:     []types.Msg{msg},
+		GasLimit: proposalGasLimit,
+	}, nil
+}
+
+// findProposal returns the ID of the latest proposal of the proposer in its
+// voting period.
+func (s *GovSequence) findProposal(ctx context.Context, querier grpc.ClientConn) (uint64, error) {
+	resp, err := govv1.NewQueryClient(querier).Proposals(ctx, &govv1.QueryProposalsRequest{
+		ProposalStatus: govv1.StatusVotingPeriod,
+		Depositor:      s.proposer.String(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	var id uint64
+	for _, proposal := range resp.Proposals {
+		if proposal.Id > s.proposalID && proposal.Id > id {
+			id = proposal.Id
+		}
+	}
+	if id == 0 {
+		return 0, fmt.Errorf("proposal %d of %s not found in voting period", s.submitted, s.proposer)
+	}
+	return id, nil
+}
@@ -0,0 +1,75 @@
+package txsim
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/gogo/protobuf/grpc"
+)
+
+var _ Sequence = &IBCTransferSequence{}
+
+// ibcTransferTimeout is the timeout of the packets of the transfers, relative
+// to the time they are sent.
+const ibcTransferTimeout = 10 * time.Minute
+
+// IBCTransferSequence sets up a sequence whereby an account transfers tokens over an IBC channel of the
+// transfer port to random addresses on the counterparty chain. A relayer must be running for the transfers
+// to complete.
+type IBCTransferSequence struct {
+	channelID     string
+	amount        int
+	numIterations int
+
+	sender types.AccAddress
+	index  int
+}
+
+func NewIBCTransferSequence(channelID string, amount, numIterations int) *IBCTransferSequence {
+	return &IBCTransferSequence{
+		channelID:     channelID,
+		amount:        amount,
+		numIterations: numIterations,
+	}
+}
+
+func (s *IBCTransferSequence) Clone(n int) []Sequence {
+	sequenceGroup := make([]Sequence, n)
+	for i := 0; i < n; i++ {
+		sequenceGroup[i] = NewIBCTransferSequence(s.channelID, s.amount, s.numIterations)
+	}
+	return sequenceGroup
+}
+
+func (s *IBCTransferSequence) Init(_ context.Context, _ grpc.ClientConn, allocateAccounts AccountAllocator, _ *rand.Rand, useFeegrant bool) {
+	funds := fundsForGas
+	if useFeegrant {
+		funds = 1
+	}
+	s.sender = allocateAccounts(1, s.numIterations*s.amount+funds)[0]
+}
+
+func (s *IBCTransferSequence) Next(_ context.Context, _ grpc.ClientConn, rand *rand.Rand) (Operation, error) {
+	if s.index >= s.numIterations {
+		return Operation{}, ErrEndOfSequence
+	}
+	receiver := make(types.AccAddress, 20)
+	_, _ = rand.Read(receiver)
+	s.index++
+	return Operation{
+		Msgs: []types.Msg{
+			ibctransfertypes.NewMsgTransfer(
+				ibctransfertypes.PortID, s.channelID,
+				types.NewInt64Coin(appconsts.BondDenom, int64(s.amount)),
+				s.sender.String(), receiver.String(),
+				clienttypes.ZeroHeight(), uint64(time.Now().Add(ibcTransferTimeout).UnixNano()), "",
+			),
+		},
+		Delay: uint64(rand.Int63n(3)),
+	}, nil
+}
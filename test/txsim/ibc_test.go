@@ -0,0 +1,43 @@
+package txsim
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBCTransferSequence(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sequence := NewIBCTransferSequence("channel-7", 1000, 3)
+	sequence.Init(context.Background(), nil, testAllocator(r), r, false)
+
+	receivers := make(map[string]bool)
+	for i := 0; i < 3; i++ {
+		op, err := sequence.Next(context.Background(), nil, r)
+		require.NoError(t, err)
+		require.Len(t, op.Msgs, 1)
+		require.NoError(t, op.Msgs[0].ValidateBasic())
+
+		transfer, ok := op.Msgs[0].(*ibctransfertypes.MsgTransfer)
+		require.True(t, ok)
+		assert.Equal(t, ibctransfertypes.PortID, transfer.SourcePort)
+		assert.Equal(t, "channel-7", transfer.SourceChannel)
+		assert.Equal(t, appconsts.BondDenom, transfer.Token.Denom)
+		assert.Equal(t, int64(1000), transfer.Token.Amount.Int64())
+		assert.Equal(t, sequence.sender.String(), transfer.Sender)
+		assert.True(t, transfer.TimeoutHeight.IsZero())
+		assert.Greater(t, transfer.TimeoutTimestamp, uint64(time.Now().UnixNano()))
+		receivers[transfer.Receiver] = true
+	}
+	// every transfer goes to a new random address
+	assert.Len(t, receivers, 3)
+
+	_, err := sequence.Next(context.Background(), nil, r)
+	assert.ErrorIs(t, err, ErrEndOfSequence)
+}
@@ -0,0 +1,410 @@
+package txsim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/gogo/protobuf/proto"
+	"github.com/rs/zerolog/log"
+)
+
+// Arrival is the distribution of the times between the operations of an open
+// loop run.
+type Arrival string
+
+const (
+	// ArrivalConstant emits operations at a fixed interval.
+	ArrivalConstant Arrival = "constant"
+	// ArrivalPoisson emits operations following a Poisson process: the
+	// intervals between operations are exponentially distributed.
+	ArrivalPoisson Arrival = "poisson"
+)
+
+const (
+	DefaultMaxInFlightPerSequence = 10
+	DefaultConfirmTimeout         = time.Minute
+)
+
+// LoadConfig configures an open loop run. Exactly one of TPS and
+// BytesPerSecond must be set.
+type LoadConfig struct {
+	// TPS is the target number of operations per second.
+	TPS float64
+	// BytesPerSecond is the target number of bytes per second, counting the
+	// blobs and the messages of the operations.
+	BytesPerSecond float64
+	// Arrival defaults to ArrivalConstant.
+	Arrival Arrival
+	// MaxInFlightPerSequence is the maximum number of operations of a sequence
+	// broadcast but not yet committed. It only applies to the sequences
+	// implementing IndependentSequence, the other ones have at most one
+	// operation in flight. An operation due when every sequence has reached
+	// its limit is dropped.
+	MaxInFlightPerSequence int
+	// ConfirmTimeout is the time after which a broadcast operation that isn't
+	// committed is counted as dropped.
+	ConfirmTimeout time.Duration
+	// Duration of the run. If zero, the run lasts until the context is done or
+	// all the sequences have ended.
+	Duration time.Duration
+}
+
+// Fill sets the defaults of the unset fields.
+func (c *LoadConfig) Fill() {
+	if c.Arrival == "" {
+		c.Arrival = ArrivalConstant
+	}
+	if c.MaxInFlightPerSequence == 0 {
+		c.MaxInFlightPerSequence = DefaultMaxInFlightPerSequence
+	}
+	if c.ConfirmTimeout == 0 {
+		c.ConfirmTimeout = DefaultConfirmTimeout
+	}
+}
+
+// Validate checks that a single target rate and a known arrival distribution
+// are set.
+func (c LoadConfig) Validate() error {
+	if (c.TPS > 0) == (c.BytesPerSecond > 0) {
+		return errors.New("exactly one of the target TPS and bytes per second must be set")
+	}
+	if c.TPS < 0 || c.BytesPerSecond < 0 {
+		return errors.New("target rates can not be negative")
+	}
+	if c.Arrival != ArrivalConstant && c.Arrival != ArrivalPoisson {
+		return fmt.Errorf("unknown arrival distribution %q", c.Arrival)
+	}
+	if c.MaxInFlightPerSequence < 0 || c.ConfirmTimeout < 0 || c.Duration < 0 {
+		return errors.New("max in flight, confirm timeout and duration can not be negative")
+	}
+	return nil
+}
+
+// LoadReport summarizes an open loop run.
+type LoadReport struct {
+	Duration time.Duration `json:"duration"`
+	// Scheduled is the number of operations due during the run, Submitted the
+	// number accepted by the mempool and Committed the number included in a
+	// block with a successful result.
+	Scheduled int `json:"scheduled"`
+	Submitted int `json:"submitted"`
+	Committed int `json:"committed"`
+	// Rejected is the number of operations rejected by CheckTx or failing in
+	// a block, by result code in RejectionCodes.
+	Rejected       int            `json:"rejected"`
+	RejectionCodes map[uint32]int `json:"rejection_codes"`
+	// Dropped is the number of operations not sent because all sequences had
+	// too many operations in flight, or not committed within the confirm
+	// timeout.
+	Dropped int `json:"dropped"`
+	// Errors is the number of operations that failed for another reason, such
+	// as a sequence or connection error.
+	Errors int `json:"errors"`
+
+	TargetTPS              float64 `json:"target_tps"`
+	AchievedTPS            float64 `json:"achieved_tps"`
+	TargetBytesPerSecond   float64 `json:"target_bytes_per_second"`
+	AchievedBytesPerSecond float64 `json:"achieved_bytes_per_second"`
+
+	// Latency from broadcast to commit of the committed operations.
+	Latency LatencyStats `json:"latency"`
+}
+
+// LatencyStats are the percentiles of a set of latencies.
+type LatencyStats struct {
+	Mean time.Duration `json:"mean"`
+	P50  time.Duration `json:"p50"`
+	P90  time.Duration `json:"p90"`
+	P99  time.Duration `json:"p99"`
+	Max  time.Duration `json:"max"`
+}
+
+// NewLatencyStats computes the stats of the latencies.
+func NewLatencyStats(latencies []time.Duration) LatencyStats {
+	if len(latencies) == 0 {
+		return LatencyStats{}
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var total time.Duration
+	for _, latency := range sorted {
+		total += latency
+	}
+	percentile := func(p float64) time.Duration {
+		return sorted[int(p*float64(len(sorted)-1))]
+	}
+	return LatencyStats{
+		Mean: total / time.Duration(len(sorted)),
+		P50:  percentile(0.5),
+		P90:  percentile(0.9),
+		P99:  percentile(0.99),
+		Max:  sorted[len(sorted)-1],
+	}
+}
+
+// RunOpenLoop is the entrypoint for running txsim in open loop mode. Unlike
+// Run, where each sequence waits for its operation to be committed before
+// submitting the next one, operations are emitted at the target rate
+// regardless of how long they take to be committed. Operations are taken from
+// the sequences in turn and broadcast from their accounts while the previous
+// ones are still in flight, if the sequence declares its operations
+// independent (see IndependentSequence). The operations of the other sequences
+// are submitted one at a time so that each one sees the state left by the
+// previous one. The delays of the operations are ignored. The run
+// ends after the configured duration, when the context is done or when all
+// sequences have ended, once the operations in flight are committed or timed
+// out.
+func RunOpenLoop(
+	ctx context.Context,
+	grpcEndpoint string,
+	keys keyring.Keyring,
+	encCfg encoding.Config,
+	opts *Options,
+	load LoadConfig,
+	sequences ...Sequence,
+) (LoadReport, error) {
+	opts.Fill()
+	load.Fill()
+	if err := load.Validate(); err != nil {
+		return LoadReport{}, err
+	}
+	if len(sequences) == 0 {
+		return LoadReport{}, errors.New("no sequences specified")
+	}
+	manager, err := setup(ctx, grpcEndpoint, keys, encCfg, opts, sequences)
+	if err != nil {
+		return LoadReport{}, err
+	}
+	stop, err := startResults(manager, opts)
+	if err != nil {
+		return LoadReport{}, err
+	}
+
+	runCtx := ctx
+	if load.Duration > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, load.Duration)
+		defer cancel()
+	}
+	loop := newOpenLoop(manager, load, sequences, rand.New(rand.NewSource(opts.seed)))
+	report := loop.run(runCtx)
+	stop()
+	if ctx.Err() != nil {
+		return report, ctx.Err()
+	}
+	return report, nil
+}
+
+// openLoop dispatches the operations of the sequences at the target rate.
+type openLoop struct {
+	manager   *AccountManager
+	load      LoadConfig
+	sequences []Sequence
+	rand      *rand.Rand
+
+	// maxInFlight is the maximum number of operations in flight of each
+	// sequence.
+	maxInFlight []int
+
+	mtx sync.Mutex
+	// inFlight is the number of operations in flight of each sequence.
+	inFlight []int
+	// ended marks the sequences that returned an error.
+	ended     []bool
+	report    LoadReport
+	latencies []time.Duration
+	bytes     int
+}
+
+func newOpenLoop(manager *AccountManager, load LoadConfig, sequences []Sequence, r *rand.Rand) *openLoop {
+	maxInFlight := make([]int, len(sequences))
+	for i, sequence := range sequences {
+		maxInFlight[i] = 1
+		if hasIndependentOperations(sequence) {
+			maxInFlight[i] = load.MaxInFlightPerSequence
+		}
+	}
+	return &openLoop{
+		manager:     manager,
+		load:        load,
+		sequences:   sequences,
+		rand:        r,
+		maxInFlight: maxInFlight,
+		inFlight:    make([]int, len(sequences)),
+		ended:       make([]bool, len(sequences)),
+		report: LoadReport{
+			RejectionCodes:       make(map[uint32]int),
+			TargetTPS:            load.TPS,
+			TargetBytesPerSecond: load.BytesPerSecond,
+		},
+	}
+}
+
+func (l *openLoop) run(ctx context.Context) LoadReport {
+	var wg sync.WaitGroup
+	start := time.Now()
+	next := start
+	index := 0
+	for {
+		if err := sleep(ctx, time.Until(next)); err != nil {
+			break
+		}
+		seqID, ok := l.nextSequence(index)
+		if !ok {
+			if l.allEnded() {
+				break
+			}
+			// every sequence is saturated, the operation is dropped
+			l.record(func(r *LoadReport) {
+				r.Scheduled++
+				r.Dropped++
+			})
+			next = next.Add(l.interval(1))
+			continue
+		}
+		index = seqID + 1
+
+		op, err := l.sequences[seqID].Next(ctx, l.manager.conn, l.rand)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			l.mtx.Lock()
+			l.ended[seqID] = true
+			l.mtx.Unlock()
+			if !errors.Is(err, ErrEndOfSequence) {
+				log.Err(err).Int("sequence", seqID).Msg("sequence failed")
+				l.record(func(r *LoadReport) { r.Errors++ })
+			}
+			continue
+		}
+		size := operationSize(op)
+		l.record(func(r *LoadReport) { r.Scheduled++ })
+		if l.load.BytesPerSecond > 0 {
+			next = next.Add(l.interval(float64(size)))
+		} else {
+			next = next.Add(l.interval(1))
+		}
+
+		l.mtx.Lock()
+		l.inFlight[seqID]++
+		l.mtx.Unlock()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.submit(ctx, op, size)
+			l.mtx.Lock()
+			l.inFlight[seqID]--
+			l.mtx.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	report := l.report
+	report.Duration = time.Since(start)
+	report.Latency = NewLatencyStats(l.latencies)
+	if seconds := report.Duration.Seconds(); seconds > 0 {
+		report.AchievedTPS = float64(report.Committed) / seconds
+		report.AchievedBytesPerSecond = float64(l.bytes) / seconds
+	}
+	return report
+}
+
+// submit broadcasts the operation and waits for it to be committed. The
+// confirmation outlives the context of the run, up to the confirm timeout.
+func (l *openLoop) submit(ctx context.Context, op Operation, size int) {
+	address, err := validateOperation(op)
+	if err != nil {
+		log.Err(err).Msg("invalid operation")
+		l.record(func(r *LoadReport) { r.Errors++ })
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), l.load.ConfirmTimeout)
+	defer cancel()
+
+	result, err := l.manager.execute(ctx, address, op)
+	l.record(func(r *LoadReport) {
+		if result.Accepted {
+			r.Submitted++
+		}
+		switch {
+		case err == nil:
+			r.Committed++
+			l.latencies = append(l.latencies, result.Latency)
+			l.bytes += size
+		case result.Rejected():
+			r.Rejected++
+			r.RejectionCodes[result.Code]++
+		case errors.Is(err, context.DeadlineExceeded):
+			r.Dropped++
+		default:
+			log.Err(err).Msg("tx failed")
+			r.Errors++
+		}
+	})
+}
+
+func (l *openLoop) record(update func(r *LoadReport)) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	update(&l.report)
+}
+
+// nextSequence returns the first sequence from index, in turn, that hasn't
+// ended and can have another operation in flight.
+func (l *openLoop) nextSequence(index int) (int, bool) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for i := 0; i < len(l.sequences); i++ {
+		seqID := (index + i) % len(l.sequences)
+		if !l.ended[seqID] && l.inFlight[seqID] < l.maxInFlight[seqID] {
+			return seqID, true
+		}
+	}
+	return 0, false
+}
+
+func (l *openLoop) allEnded() bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for _, ended := range l.ended {
+		if !ended {
+			return false
+		}
+	}
+	return true
+}
+
+// interval returns the time until the next operation after one of the given
+// cost, in operations or bytes depending on the target rate.
+func (l *openLoop) interval(cost float64) time.Duration {
+	rate := l.load.TPS
+	if l.load.BytesPerSecond > 0 {
+		rate = l.load.BytesPerSecond
+	}
+	mean := cost / rate * float64(time.Second)
+	if l.load.Arrival == ArrivalPoisson {
+		return time.Duration(l.rand.ExpFloat64() * mean)
+	}
+	return time.Duration(mean)
+}
+
+// operationSize returns the number of bytes of the blobs and the messages of
+// the operation.
+func operationSize(op Operation) int {
+	size := int(getSize(op.Blobs))
+	for _, msg := range op.Msgs {
+		size += proto.Size(msg)
+	}
+	return size
+}
@@ -0,0 +1,104 @@
+package txsim
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config LoadConfig
+		valid  bool
+	}{
+		{"tps", LoadConfig{TPS: 10}, true},
+		{"bytes per second", LoadConfig{BytesPerSecond: 1000, Arrival: ArrivalPoisson}, true},
+		{"no rate", LoadConfig{}, false},
+		{"both rates", LoadConfig{TPS: 10, BytesPerSecond: 1000}, false},
+		{"negative rate", LoadConfig{TPS: -1}, false},
+		{"unknown arrival", LoadConfig{TPS: 10, Arrival: "bursty"}, false},
+		{"negative duration", LoadConfig{TPS: 10, Duration: -time.Second}, false},
+	}
+	for _, tc := range testCases {
+		tc.config.Fill()
+		err := tc.config.Validate()
+		if tc.valid {
+			assert.NoError(t, err, tc.name)
+		} else {
+			assert.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestNewLatencyStats(t *testing.T) {
+	assert.Equal(t, LatencyStats{}, NewLatencyStats(nil))
+
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		// in reverse order to check that the latencies are sorted
+		latencies[i] = time.Duration(100-i) * time.Millisecond
+	}
+	stats := NewLatencyStats(latencies)
+	assert.Equal(t, 50*time.Millisecond, stats.P50)
+	assert.Equal(t, 90*time.Millisecond, stats.P90)
+	assert.Equal(t, 99*time.Millisecond, stats.P99)
+	assert.Equal(t, 100*time.Millisecond, stats.Max)
+	assert.Equal(t, 50500*time.Microsecond, stats.Mean)
+	assert.Equal(t, time.Duration(100)*time.Millisecond, latencies[0], "the input must not be modified")
+}
+
+func TestOpenLoopInterval(t *testing.T) {
+	constant := newOpenLoop(nil, LoadConfig{TPS: 4, Arrival: ArrivalConstant}, nil, rand.New(rand.NewSource(1)))
+	assert.Equal(t, 250*time.Millisecond, constant.interval(1))
+
+	bytes := newOpenLoop(nil, LoadConfig{BytesPerSecond: 1000, Arrival: ArrivalConstant}, nil, rand.New(rand.NewSource(1)))
+	assert.Equal(t, 500*time.Millisecond, bytes.interval(500))
+
+	// the mean of the intervals of a Poisson process is the inverse of its rate
+	poisson := newOpenLoop(nil, LoadConfig{TPS: 10, Arrival: ArrivalPoisson}, nil, rand.New(rand.NewSource(1)))
+	var total time.Duration
+	const n = 10000
+	for i := 0; i < n; i++ {
+		total += poisson.interval(1)
+	}
+	require.InDelta(t, float64(100*time.Millisecond), float64(total/n), float64(5*time.Millisecond))
+}
+
+func TestOpenLoopNextSequence(t *testing.T) {
+	sequences := []Sequence{&countingSequence{}, &countingSequence{}, &countingSequence{}}
+	loop := newOpenLoop(nil, LoadConfig{TPS: 1, MaxInFlightPerSequence: 1}, sequences, rand.New(rand.NewSource(1)))
+
+	seqID, ok := loop.nextSequence(0)
+	require.True(t, ok)
+	assert.Equal(t, 0, seqID)
+
+	loop.inFlight[1] = 1
+	loop.ended[2] = true
+	seqID, ok = loop.nextSequence(1)
+	require.True(t, ok)
+	assert.Equal(t, 0, seqID)
+
+	loop.inFlight[0] = 1
+	_, ok = loop.nextSequence(0)
+	assert.False(t, ok)
+	assert.False(t, loop.allEnded())
+}
+
+func TestOpenLoopMaxInFlight(t *testing.T) {
+	blobs := NewBlobSequence(NewRange(100, 1000), NewRange(1, 3))
+	scheduledBlobs := &scheduledSequence{sequence: NewBlobSequence(NewRange(100, 1000), NewRange(1, 3))}
+	sequences := []Sequence{blobs, scheduledBlobs, &countingSequence{}, NewGovSequence(2, 1)}
+	loop := newOpenLoop(nil, LoadConfig{TPS: 1, MaxInFlightPerSequence: 3}, sequences, rand.New(rand.NewSource(1)))
+
+	// the operations of the dependent sequences are submitted one at a time
+	assert.Equal(t, []int{3, 3, 1, 1}, loop.maxInFlight)
+
+	loop.inFlight = []int{2, 3, 1, 1}
+	seqID, ok := loop.nextSequence(1)
+	require.True(t, ok)
+	assert.Equal(t, 0, seqID)
+}
@@ -0,0 +1,325 @@
+package txsim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+)
+
+// OperationResult is the outcome of a single operation submitted by txsim.
+type OperationResult struct {
+	Address string `json:"address"`
+	// Msgs are the type URLs of the messages of the operation.
+	Msgs      []string `json:"msgs"`
+	BlobBytes int      `json:"blob_bytes"`
+	TxHash    string   `json:"tx_hash,omitempty"`
+	// Accepted is true if the transaction passed CheckTx.
+	Accepted bool `json:"accepted"`
+	// Latency from the submission of the transaction to its commit. Zero if it
+	// wasn't committed.
+	Latency time.Duration `json:"latency"`
+	// GasWanted is the gas limit set by txsim, GasUsed the gas consumed when
+	// the transaction was executed.
+	GasWanted uint64 `json:"gas_wanted"`
+	GasUsed   int64  `json:"gas_used"`
+	// Fee in utia, paid if the transaction was included in a block.
+	Fee       uint64 `json:"fee"`
+	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace,omitempty"`
+	// Height of the block including the transaction. Zero if it wasn't
+	// included.
+	Height int64  `json:"height"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Committed returns true if the transaction was included in a block and
+// executed successfully.
+func (r OperationResult) Committed() bool {
+	return r.Height > 0 && r.Code == 0 && r.Error == ""
+}
+
+// Rejected returns true if the transaction failed CheckTx or its execution
+// in a block.
+func (r OperationResult) Rejected() bool {
+	return r.Code != 0
+}
+
+// rejectionCode returns the key of the rejection in Report.RejectionCodes.
+func (r OperationResult) rejectionCode() string {
+	return fmt.Sprintf("%s/%d", r.Codespace, r.Code)
+}
+
+// newOperationResult returns the result of an operation not yet submitted.
+func newOperationResult(address types.AccAddress, op Operation) OperationResult {
+	msgs := make([]string, len(op.Msgs))
+	for i, msg := range op.Msgs {
+		msgs[i] = types.MsgTypeURL(msg)
+	}
+	gasLimit, fee := operationGas(op)
+	return OperationResult{
+		Address:   address.String(),
+		Msgs:      msgs,
+		BlobBytes: int(getSize(op.Blobs)),
+		GasWanted: gasLimit,
+		Fee:       fee,
+	}
+}
+
+// update sets the fields of the result from the response and error of the
+// broadcast or confirmation of the transaction.
+func (r *OperationResult) update(res *types.TxResponse, err error) {
+	if res != nil {
+		if res.TxHash != "" {
+			r.TxHash = res.TxHash
+		}
+		r.Code = res.Code
+		r.Codespace = res.Codespace
+		r.Height = res.Height
+		r.GasUsed = res.GasUsed
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+}
+
+// Report summarizes the results of the operations of a run.
+type Report struct {
+	Duration time.Duration `json:"duration"`
+	// Operations is the number of operations submitted, of which Committed
+	// were committed successfully, Rejected failed CheckTx or their execution
+	// and Failed were not committed for another reason such as a timeout.
+	Operations int `json:"operations"`
+	Committed  int `json:"committed"`
+	Rejected   int `json:"rejected"`
+	Failed     int `json:"failed"`
+	// RejectionCodes counts the rejections by "codespace/code".
+	RejectionCodes map[string]int `json:"rejection_codes"`
+	// Messages counts the committed messages by type URL.
+	Messages map[string]int `json:"messages"`
+	// Latency from submission to commit of the committed operations.
+	Latency LatencyStats `json:"latency"`
+	// GasWanted and GasUsed are the totals of the transactions included in a
+	// block. GasEfficiency is the ratio of the gas used to the gas wanted.
+	GasWanted     uint64  `json:"gas_wanted"`
+	GasUsed       int64   `json:"gas_used"`
+	GasEfficiency float64 `json:"gas_efficiency"`
+	// FeesPaid is the total of the fees, in utia, of the transactions
+	// included in a block.
+	FeesPaid uint64 `json:"fees_paid"`
+	// BlobBytes is the total size of the blobs committed.
+	BlobBytes int `json:"blob_bytes"`
+	// FirstHeight and LastHeight are the heights of the first and last blocks
+	// including a transaction.
+	FirstHeight int64 `json:"first_height"`
+	LastHeight  int64 `json:"last_height"`
+	// Throughput is the number of operations committed per second.
+	Throughput float64 `json:"throughput"`
+}
+
+// Collector collects the results of the operations of a run, to produce a
+// Report and expose them as Prometheus metrics. It is thread safe.
+type Collector struct {
+	start   time.Time
+	metrics *collectorMetrics
+
+	mtx       sync.Mutex
+	report    Report
+	latencies []time.Duration
+}
+
+type collectorMetrics struct {
+	registry   *prometheus.Registry
+	operations *prometheus.CounterVec
+	rejections *prometheus.CounterVec
+	latency    prometheus.Histogram
+	gasWanted  prometheus.Counter
+	gasUsed    prometheus.Counter
+	fees       prometheus.Counter
+	blobBytes  prometheus.Counter
+	height     prometheus.Gauge
+}
+
+func NewCollector() *Collector {
+	return &Collector{
+		start: time.Now(),
+		report: Report{
+			RejectionCodes: make(map[string]int),
+			Messages:       make(map[string]int),
+		},
+		metrics: newCollectorMetrics(),
+	}
+}
+
+func newCollectorMetrics() *collectorMetrics {
+	m := &collectorMetrics{
+		registry: prometheus.NewRegistry(),
+		operations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "txsim",
+			Name:      "operations_total",
+			Help:      "Number of operations submitted by status: committed, rejected or failed.",
+		}, []string{"status"}),
+		rejections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "txsim",
+			Name:      "rejections_total",
+			Help:      "Number of rejected transactions by codespace and code.",
+		}, []string{"codespace", "code"}),
+		latency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "txsim",
+			Name:      "commit_latency_seconds",
+			Help:      "Latency from submission to commit of the committed transactions.",
+			Buckets:   prometheus.ExponentialBuckets(0.25, 2, 10),
+		}),
+		gasWanted: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "txsim",
+			Name:      "gas_wanted_total",
+			Help:      "Gas limit of the transactions included in a block.",
+		}),
+		gasUsed: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "txsim",
+			Name:      "gas_used_total",
+			Help:      "Gas used by the transactions included in a block.",
+		}),
+		fees: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "txsim",
+			Name:      "fees_paid_utia_total",
+			Help:      "Fees paid by the transactions included in a block.",
+		}),
+		blobBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "txsim",
+			Name:      "blob_bytes_total",
+			Help:      "Size of the blobs committed.",
+		}),
+		height: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "txsim",
+			Name:      "inclusion_height",
+			Help:      "Height of the last block including a transaction.",
+		}),
+	}
+	m.registry.MustRegister(m.operations, m.rejections, m.latency, m.gasWanted, m.gasUsed, m.fees, m.blobBytes, m.height)
+	return m
+}
+
+// Record adds the result of an operation.
+func (c *Collector) Record(result OperationResult) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	r := &c.report
+	r.Operations++
+	switch {
+	case result.Committed():
+		r.Committed++
+		for _, msg := range result.Msgs {
+			r.Messages[msg]++
+		}
+		r.BlobBytes += result.BlobBytes
+		c.latencies = append(c.latencies, result.Latency)
+		c.metrics.operations.WithLabelValues("committed").Inc()
+		c.metrics.latency.Observe(result.Latency.Seconds())
+		c.metrics.blobBytes.Add(float64(result.BlobBytes))
+	case result.Rejected():
+		r.Rejected++
+		r.RejectionCodes[result.rejectionCode()]++
+		c.metrics.operations.WithLabelValues("rejected").Inc()
+		c.metrics.rejections.WithLabelValues(result.Codespace, strconv.FormatUint(uint64(result.Code), 10)).Inc()
+	default:
+		r.Failed++
+		c.metrics.operations.WithLabelValues("failed").Inc()
+	}
+
+	if result.Height > 0 {
+		r.GasWanted += result.GasWanted
+		r.GasUsed += result.GasUsed
+		r.FeesPaid += result.Fee
+		if r.FirstHeight == 0 || result.Height < r.FirstHeight {
+			r.FirstHeight = result.Height
+		}
+		if result.Height > r.LastHeight {
+			r.LastHeight = result.Height
+			c.metrics.height.Set(float64(result.Height))
+		}
+		c.metrics.gasWanted.Add(float64(result.GasWanted))
+		c.metrics.gasUsed.Add(float64(result.GasUsed))
+		c.metrics.fees.Add(float64(result.Fee))
+	}
+}
+
+// Report returns the summary of the results recorded so far.
+func (c *Collector) Report() Report {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	report := c.report
+	report.RejectionCodes = make(map[string]int, len(c.report.RejectionCodes))
+	for code, count := range c.report.RejectionCodes {
+		report.RejectionCodes[code] = count
+	}
+	report.Messages = make(map[string]int, len(c.report.Messages))
+	for msg, count := range c.report.Messages {
+		report.Messages[msg] = count
+	}
+	report.Duration = time.Since(c.start)
+	report.Latency = NewLatencyStats(c.latencies)
+	if report.GasWanted > 0 {
+		report.GasEfficiency = float64(report.GasUsed) / float64(report.GasWanted)
+	}
+	if seconds := report.Duration.Seconds(); seconds > 0 {
+		report.Throughput = float64(report.Committed) / seconds
+	}
+	return report
+}
+
+// Handler returns the HTTP handler serving the Prometheus metrics at /metrics
+// and the JSON encoded report at /report.
+func (c *Collector) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(c.metrics.registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/report", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(c.Report()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+	return mux
+}
+
+// WriteReport writes the JSON encoded report to the file at path.
+func (c *Collector) WriteReport(path string) error {
+	bz, err := json.MarshalIndent(c.Report(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, 0o644)
+}
+
+// serveMetrics serves the handler of the collector on the listener until the
+// returned function is called, which also closes the listener.
+func serveMetrics(listener net.Listener, collector *Collector) func() {
+	server := &http.Server{
+		Handler:           collector.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Err(err).Msg("serving metrics")
+		}
+	}()
+	log.Info().Str("address", listener.Addr().String()).Msg("serving metrics")
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			log.Err(err).Msg("shutting down the metrics server")
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package txsim
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector(t *testing.T) {
+	collector := NewCollector()
+	collector.Record(OperationResult{
+		Msgs:      []string{"/cosmos.bank.v1beta1.MsgSend"},
+		Accepted:  true,
+		Latency:   2 * time.Second,
+		GasWanted: 100000,
+		GasUsed:   60000,
+		Fee:       200,
+		Height:    10,
+	})
+	collector.Record(OperationResult{
+		Msgs:      []string{"/celestia.blob.v1.MsgPayForBlobs"},
+		BlobBytes: 1000,
+		Accepted:  true,
+		Latency:   4 * time.Second,
+		GasWanted: 100000,
+		GasUsed:   90000,
+		Fee:       200,
+		Height:    12,
+	})
+	// failed in a block: the fee is paid
+	collector.Record(OperationResult{
+		Msgs:      []string{"/cosmos.bank.v1beta1.MsgSend"},
+		Accepted:  true,
+		GasWanted: 100000,
+		GasUsed:   100000,
+		Fee:       200,
+		Code:      11,
+		Codespace: "sdk",
+		Height:    11,
+		Error:     "out of gas",
+	})
+	// failed CheckTx
+	collector.Record(OperationResult{
+		Msgs:      []string{"/cosmos.bank.v1beta1.MsgSend"},
+		GasWanted: 100000,
+		Fee:       200,
+		Code:      13,
+		Codespace: "sdk",
+		Error:     "insufficient fee",
+	})
+	// timed out
+	collector.Record(OperationResult{
+		Msgs:     []string{"/cosmos.bank.v1beta1.MsgSend"},
+		Accepted: true,
+		Error:    "context deadline exceeded",
+	})
+
+	report := collector.Report()
+	assert.Equal(t, 5, report.Operations)
+	assert.Equal(t, 2, report.Committed)
+	assert.Equal(t, 2, report.Rejected)
+	assert.Equal(t, 1, report.Failed)
+	assert.Equal(t, map[string]int{"sdk/11": 1, "sdk/13": 1}, report.RejectionCodes)
+	assert.Equal(t, map[string]int{
+		"/cosmos.bank.v1beta1.MsgSend":     1,
+		"/celestia.blob.v1.MsgPayForBlobs": 1,
+	}, report.Messages)
+	assert.Equal(t, uint64(300000), report.GasWanted)
+	assert.Equal(t, int64(250000), report.GasUsed)
+	assert.InDelta(t, 250000.0/300000, report.GasEfficiency, 1e-9)
+	assert.Equal(t, uint64(600), report.FeesPaid)
+	assert.Equal(t, 1000, report.BlobBytes)
+	assert.Equal(t, int64(10), report.FirstHeight)
+	assert.Equal(t, int64(12), report.LastHeight)
+	assert.Equal(t, 4*time.Second, report.Latency.Max)
+	assert.Equal(t, 3*time.Second, report.Latency.Mean)
+
+	// the report is a copy
+	report.RejectionCodes["sdk/11"] = 10
+	assert.Equal(t, 1, collector.Report().RejectionCodes["sdk/11"])
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, collector.WriteReport(path))
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var written Report
+	require.NoError(t, json.Unmarshal(bz, &written))
+	assert.Equal(t, 2, written.Committed)
+}
+
+func TestCollectorHandler(t *testing.T) {
+	collector := NewCollector()
+	collector.Record(OperationResult{Accepted: true, Latency: time.Second, GasWanted: 10, GasUsed: 5, Height: 3})
+	collector.Record(OperationResult{Code: 13, Codespace: "sdk"})
+	server := httptest.NewServer(collector.Handler())
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	metrics, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(metrics), `txsim_operations_total{status="committed"} 1`)
+	assert.Contains(t, string(metrics), `txsim_rejections_total{code="13",codespace="sdk"} 1`)
+	assert.Contains(t, string(metrics), `txsim_commit_latency_seconds_count 1`)
+	assert.Contains(t, string(metrics), `txsim_inclusion_height 3`)
+
+	resp, err = server.Client().Get(server.URL + "/report")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var report Report
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+	assert.Equal(t, 2, report.Operations)
+	assert.Equal(t, 1, report.Rejected)
+}
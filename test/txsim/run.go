@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net"
+	"sync"
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
@@ -37,33 +39,15 @@ func Run(
 	sequences ...Sequence,
 ) error {
 	opts.Fill()
-	r := rand.New(rand.NewSource(opts.seed))
-
-	conn, err := grpc.NewClient(grpcEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return fmt.Errorf("dialing %s: %w", grpcEndpoint, err)
-	}
-
-	if opts.suppressLogger {
-		// TODO (@cmwaters): we can do better than setting this globally
-		zerolog.SetGlobalLevel(zerolog.Disabled)
-	}
-
-	// Create the account manager to handle account transactions.
-	manager, err := NewAccountManager(ctx, keys, encCfg, opts.masterAcc, conn, opts.pollTime, opts.useFeeGrant)
+	manager, err := setup(ctx, grpcEndpoint, keys, encCfg, opts, sequences)
 	if err != nil {
 		return err
 	}
-
-	// Initialize each of the sequences by allowing them to allocate accounts.
-	for _, sequence := range sequences {
-		sequence.Init(ctx, manager.conn, manager.AllocateAccounts, r, opts.useFeeGrant)
-	}
-
-	// Generate the allotted accounts on chain by sending them sufficient funds
-	if err := manager.GenerateAccounts(ctx); err != nil {
+	stop, err := startResults(manager, opts)
+	if err != nil {
 		return err
 	}
+	defer stop()
 
 	errCh := make(chan error, len(sequences))
 
@@ -108,6 +92,7 @@ func Run(
 		finalErr = err
 	}
 
+	stop()
 	if ctx.Err() != nil {
 		return ctx.Err()
 	}
@@ -115,12 +100,95 @@ func Run(
 	return finalErr
 }
 
+// startResults serves the metrics of the run if a metrics address or listener
+// is set. The returned function, which can be called more than once, stops
+// serving them and logs the report of the run, writing it to the report file
+// if set.
+func startResults(manager *AccountManager, opts *Options) (func(), error) {
+	stopServing := func() {}
+	listener := opts.metricsListener
+	if listener == nil && opts.metricsAddress != "" {
+		var err error
+		listener, err = net.Listen("tcp", opts.metricsAddress)
+		if err != nil {
+			return nil, fmt.Errorf("serving metrics on %s: %w", opts.metricsAddress, err)
+		}
+	}
+	if listener != nil {
+		stopServing = serveMetrics(listener, manager.Results())
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			stopServing()
+			report := manager.Results().Report()
+			log.Info().
+				Int("operations", report.Operations).
+				Int("committed", report.Committed).
+				Int("rejected", report.Rejected).
+				Int("failed", report.Failed).
+				Dur("p50 latency", report.Latency.P50).
+				Dur("p99 latency", report.Latency.P99).
+				Msg("run finished")
+			if opts.reportFile == "" {
+				return
+			}
+			if err := manager.Results().WriteReport(opts.reportFile); err != nil {
+				log.Err(err).Str("path", opts.reportFile).Msg("writing the report")
+			}
+		})
+	}, nil
+}
+
+// setup connects to the node, initializes the sequences and funds the
+// accounts they allocated.
+func setup(
+	ctx context.Context,
+	grpcEndpoint string,
+	keys keyring.Keyring,
+	encCfg encoding.Config,
+	opts *Options,
+	sequences []Sequence,
+) (*AccountManager, error) {
+	r := rand.New(rand.NewSource(opts.seed))
+
+	conn, err := grpc.NewClient(grpcEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dialing %s: %w", grpcEndpoint, err)
+	}
+
+	if opts.suppressLogger {
+		// TODO (@cmwaters): we can do better than setting this globally
+		zerolog.SetGlobalLevel(zerolog.Disabled)
+	}
+
+	// Create the account manager to handle account transactions.
+	manager, err := NewAccountManager(ctx, keys, encCfg, opts.masterAcc, conn, opts.pollTime, opts.useFeeGrant)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize each of the sequences by allowing them to allocate accounts.
+	for _, sequence := range sequences {
+		sequence.Init(ctx, manager.conn, manager.AllocateAccounts, r, opts.useFeeGrant)
+	}
+
+	// Generate the allotted accounts on chain by sending them sufficient funds
+	if err := manager.GenerateAccounts(ctx); err != nil {
+		return nil, err
+	}
+	return manager, nil
+}
+
 type Options struct {
-	seed           int64
-	masterAcc      string
-	pollTime       time.Duration
-	useFeeGrant    bool
-	suppressLogger bool
+	seed            int64
+	masterAcc       string
+	pollTime        time.Duration
+	useFeeGrant     bool
+	suppressLogger  bool
+	metricsAddress  string
+	metricsListener net.Listener
+	reportFile      string
 }
 
 func (o *Options) Fill() {
@@ -162,3 +230,25 @@ func (o *Options) WithPollTime(pollTime time.Duration) *Options {
 	o.pollTime = pollTime
 	return o
 }
+
+// WithMetricsAddress serves the Prometheus metrics of the run at /metrics and
+// its JSON encoded report at /report on the address while running.
+func (o *Options) WithMetricsAddress(address string) *Options {
+	o.metricsAddress = address
+	return o
+}
+
+// WithMetricsListener serves the metrics like WithMetricsAddress, but on the
+// listener, which is closed at the end of the run. It takes precedence over
+// the metrics address.
+func (o *Options) WithMetricsListener(listener net.Listener) *Options {
+	o.metricsListener = listener
+	return o
+}
+
+// WithReportFile writes the JSON encoded report of the run to the file at the
+// end of the run.
+func (o *Options) WithReportFile(path string) *Options {
+	o.reportFile = path
+	return o
+}
@@ -6,7 +6,14 @@ package txsim_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,9 +25,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	blob "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distribution "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -121,24 +133,7 @@ func TestTxSimulator(t *testing.T) {
 			// Expect all sequences to run for at least 30 seconds without error
 			require.True(t, errors.Is(err, context.DeadlineExceeded), err.Error())
 
-			blocks, err := testnode.ReadBlockchain(context.Background(), rpcAddr)
-			require.NoError(t, err)
-			for _, block := range blocks {
-				txs, err := testnode.DecodeBlockData(block.Data)
-				require.NoError(t, err, block.Height)
-				for _, tx := range txs {
-					for _, msg := range tx.GetMsgs() {
-						if _, ok := tc.expMessages[sdk.MsgTypeURL(msg)]; ok {
-							tc.expMessages[sdk.MsgTypeURL(msg)]--
-						}
-					}
-				}
-			}
-			for msg, count := range tc.expMessages {
-				if count > 0 {
-					t.Errorf("missing %d messages of type %s (blocks: %d)", count, msg, len(blocks))
-				}
-			}
+			countMessages(t, rpcAddr, tc.expMessages)
 		})
 	}
 }
@@ -152,3 +147,156 @@ func Setup(t testing.TB) (keyring.Keyring, string, string) {
 
 	return cctx.Keyring, rpcAddr, grpcAddr
 }
+
+func TestTxSimulatorOpenLoop(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping TestTxSimulatorOpenLoop in short mode.")
+	}
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	keyring, _, grpcAddr := Setup(t)
+
+	opts := txsim.DefaultOptions().
+		SuppressLogs().
+		WithPollTime(time.Millisecond * 100)
+	sequences := append(
+		txsim.NewSendSequence(2, 1000, 100).Clone(2),
+		txsim.NewBlobSequence(txsim.NewRange(1000, 1000), txsim.NewRange(1, 2)).Clone(2)...,
+	)
+	load := txsim.LoadConfig{
+		TPS:      5,
+		Arrival:  txsim.ArrivalPoisson,
+		Duration: 20 * time.Second,
+	}
+
+	report, err := txsim.RunOpenLoop(context.Background(), grpcAddr, keyring, encCfg, opts, load, sequences...)
+	require.NoError(t, err)
+	require.Greater(t, report.Scheduled, 0)
+	require.Greater(t, report.Committed, report.Scheduled/2, report)
+	require.LessOrEqual(t, report.Committed, report.Submitted)
+	require.Greater(t, report.AchievedTPS, 0.0)
+	require.Greater(t, report.Latency.P50, time.Duration(0))
+	require.LessOrEqual(t, report.Latency.P50, report.Latency.P99)
+}
+
+func TestTxSimulatorReport(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping TestTxSimulatorReport in short mode.")
+	}
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	keyring, _, grpcAddr := Setup(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	reportFile := filepath.Join(t.TempDir(), "report.json")
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	metricsURL := "http://" + listener.Addr().String() + "/metrics"
+	opts := txsim.DefaultOptions().
+		SuppressLogs().
+		WithPollTime(time.Millisecond * 100).
+		WithMetricsListener(listener).
+		WithReportFile(reportFile)
+
+	scraped := make(chan bool)
+	go func() {
+		// the metrics are served while running
+		scraped <- assert.Eventually(t, func() bool {
+			resp, err := http.Get(metricsURL)
+			if err != nil {
+				return false
+			}
+			defer resp.Body.Close()
+			metrics, err := io.ReadAll(resp.Body)
+			return err == nil && strings.Contains(string(metrics), `txsim_operations_total{status="committed"}`)
+		}, 15*time.Second, 200*time.Millisecond)
+	}()
+
+	err = txsim.Run(ctx, grpcAddr, keyring, encCfg, opts, txsim.NewSendSequence(2, 1000, 100).Clone(2)...)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, <-scraped, "the committed operations were not scraped while running")
+
+	bz, err := os.ReadFile(reportFile)
+	require.NoError(t, err)
+	var report txsim.Report
+	require.NoError(t, json.Unmarshal(bz, &report))
+	require.Greater(t, report.Committed, 5)
+	require.GreaterOrEqual(t, report.Messages[sdk.MsgTypeURL(&bank.MsgSend{})], report.Committed)
+	require.Greater(t, report.GasUsed, int64(0))
+	require.LessOrEqual(t, report.GasEfficiency, 1.0)
+	require.Greater(t, report.FeesPaid, uint64(0))
+	require.Greater(t, report.LastHeight, int64(0))
+	require.Greater(t, report.Latency.P50, time.Duration(0))
+}
+
+func TestTxSimulatorModuleSequences(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping TestTxSimulatorModuleSequences in short mode.")
+	}
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	cfg := testnode.DefaultConfig().WithTimeoutCommit(300 * time.Millisecond).WithFundedAccounts("txsim-master")
+	cctx, rpcAddr, grpcAddr := testnode.NewNetwork(t, cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	reportFile := filepath.Join(t.TempDir(), "report.json")
+	opts := txsim.DefaultOptions().
+		SuppressLogs().
+		SpecifyMasterAccount("txsim-master").
+		WithPollTime(time.Millisecond * 100).
+		WithReportFile(reportFile)
+	err := txsim.Run(
+		ctx,
+		grpcAddr,
+		cctx.Keyring,
+		encCfg,
+		opts,
+		txsim.NewGovSequence(2, 1),
+		txsim.NewFeegrantSequence(2, 1000, 10),
+		txsim.NewAuthzSequence(2, 1000, 10),
+		txsim.NewVestingSequence(4, 1000),
+	)
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(reportFile)
+	require.NoError(t, err)
+	var report txsim.Report
+	require.NoError(t, json.Unmarshal(bz, &report))
+	require.Zero(t, report.Rejected, report.RejectionCodes)
+	require.Zero(t, report.Failed)
+
+	countMessages(t, rpcAddr, map[string]int64{
+		sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}):                 1,
+		sdk.MsgTypeURL(&govv1.MsgVote{}):                           2,
+		sdk.MsgTypeURL(&feegrant.MsgGrantAllowance{}):              2,
+		sdk.MsgTypeURL(&bank.MsgSend{}):                            10,
+		sdk.MsgTypeURL(&authz.MsgGrant{}):                          2,
+		sdk.MsgTypeURL(&authz.MsgExec{}):                           10,
+		sdk.MsgTypeURL(&vesting.MsgCreateVestingAccount{}):         2,
+		sdk.MsgTypeURL(&vesting.MsgCreatePeriodicVestingAccount{}): 1,
+		sdk.MsgTypeURL(&vesting.MsgCreatePermanentLockedAccount{}): 1,
+	})
+}
+
+// countMessages checks that the chain includes at least the expected number
+// of messages of each type.
+func countMessages(t *testing.T, rpcAddr string, expMessages map[string]int64) {
+	t.Helper()
+	blocks, err := testnode.ReadBlockchain(context.Background(), rpcAddr)
+	require.NoError(t, err)
+	for _, block := range blocks {
+		txs, err := testnode.DecodeBlockData(block.Data)
+		require.NoError(t, err, block.Height)
+		for _, tx := range txs {
+			for _, msg := range tx.GetMsgs() {
+				if _, ok := expMessages[sdk.MsgTypeURL(msg)]; ok {
+					expMessages[sdk.MsgTypeURL(msg)]--
+				}
+			}
+		}
+	}
+	for msg, count := range expMessages {
+		if count > 0 {
+			t.Errorf("missing %d messages of type %s (blocks: %d)", count, msg, len(blocks))
+		}
+	}
+}
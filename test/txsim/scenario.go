@@ -0,0 +1,525 @@
+package txsim
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ns "github.com/celestiaorg/go-square/namespace"
+	"github.com/gogo/protobuf/grpc"
+	"sigs.k8s.io/yaml"
+)
+
+// Sequence types supported by scenario files.
+const (
+	SequenceTypeBlob        = "blob"
+	SequenceTypeSend        = "send"
+	SequenceTypeStake       = "stake"
+	SequenceTypeGov         = "gov"
+	SequenceTypeFeegrant    = "feegrant"
+	SequenceTypeAuthz       = "authz"
+	SequenceTypeVesting     = "vesting"
+	SequenceTypeIBCTransfer = "ibc_transfer"
+	SequenceTypeCustom      = "custom"
+)
+
+// Scenario describes a reproducible load profile for txsim. Scenarios are
+// written in YAML or JSON and turned into sequences by BuildSequences:
+//
+//	name: mixed
+//	seed: 1234
+//	poll_time: 1s
+//	sequences:
+//	  - type: blob
+//	    count: 10
+//	    blob_sizes: 1000-10000
+//	    blobs_per_pfb: 1-3
+//	    rate: 2
+//	phases:
+//	  - name: send burst
+//	    start: 1m
+//	    duration: 30s
+//	    sequences:
+//	      - type: send
+//	        count: 20
+//
+// Sequences listed at the top level run for the whole run while the sequences
+// of a phase only submit operations between the start of the phase and its
+// end, relative to the first operation of the scenario.
+type Scenario struct {
+	Name string `json:"name"`
+	// Seed, PollTime and UseFeegrant set the corresponding options of Run.
+	Seed        int64    `json:"seed"`
+	PollTime    Duration `json:"poll_time"`
+	UseFeegrant bool     `json:"use_feegrant"`
+
+	Sequences []SequenceSpec `json:"sequences"`
+	Phases    []Phase        `json:"phases"`
+}
+
+// Phase is a group of sequences that only run during a window of time.
+type Phase struct {
+	Name  string   `json:"name"`
+	Start Duration `json:"start"`
+	// Duration of the phase. If zero, the phase runs until the end of the run.
+	Duration  Duration       `json:"duration"`
+	Sequences []SequenceSpec `json:"sequences"`
+}
+
+// SequenceSpec describes a sequence and the number of clones of it to run.
+// Only the fields of the type of the sequence are used.
+type SequenceSpec struct {
+	Type string `json:"type"`
+	// Count is the number of clones of the sequence. It defaults to 1.
+	Count int `json:"count"`
+	// Rate is the maximum number of operations per second submitted by each
+	// clone. If zero, operations are submitted as fast as they are confirmed.
+	Rate float64 `json:"rate"`
+	// Seed overrides the seed of the scenario for the randomness of the
+	// sequence. The i-th clone of the sequence uses Seed+i so that the clones
+	// don't repeat the same operations.
+	Seed int64 `json:"seed"`
+
+	// blob sequences
+	BlobSizes Range `json:"blob_sizes"`
+	// BlobsPerPFB defaults to a single blob per PFB.
+	BlobsPerPFB Range `json:"blobs_per_pfb"`
+	// Namespace is the hex encoded ID of a version 0 namespace used for all
+	// the blobs. If empty, each blob has a random namespace.
+	Namespace string `json:"namespace"`
+
+	// send, feegrant, authz, vesting and ibc_transfer sequences. Accounts
+	// is the number of grantees of feegrant and authz sequences and the
+	// number of accounts created by vesting sequences.
+	Accounts   int `json:"accounts"`
+	Amount     int `json:"amount"`
+	Iterations int `json:"iterations"`
+
+	// stake sequences
+	InitialStake int `json:"initial_stake"`
+
+	// gov sequences
+	Voters    int `json:"voters"`
+	Proposals int `json:"proposals"`
+
+	// ibc_transfer sequences
+	Channel string `json:"channel"`
+
+	// custom sequences, see CustomSequence
+	Msgs     []json.RawMessage `json:"msgs"`
+	Balance  int               `json:"balance"`
+	GasLimit uint64            `json:"gas_limit"`
+}
+
+// LoadScenario reads and validates a scenario from a YAML or JSON file.
+func LoadScenario(path string) (*Scenario, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	scenario, err := ParseScenario(bz)
+	if err != nil {
+		return nil, fmt.Errorf("scenario %s: %w", path, err)
+	}
+	return scenario, nil
+}
+
+// ParseScenario parses and validates a scenario written in YAML or JSON.
+// Unknown fields are rejected.
+func ParseScenario(bz []byte) (*Scenario, error) {
+	var scenario Scenario
+	if err := yaml.UnmarshalStrict(bz, &scenario); err != nil {
+		return nil, err
+	}
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
+	return &scenario, nil
+}
+
+// Validate checks that the scenario describes at least one sequence and that
+// every sequence is valid.
+func (s *Scenario) Validate() error {
+	total := len(s.Sequences)
+	for i, spec := range s.Sequences {
+		if err := spec.validate(); err != nil {
+			return fmt.Errorf("sequence %d: %w", i, err)
+		}
+	}
+	for i, phase := range s.Phases {
+		if phase.Start < 0 || phase.Duration < 0 {
+			return fmt.Errorf("phase %d (%s): start and duration can not be negative", i, phase.Name)
+		}
+		if len(phase.Sequences) == 0 {
+			return fmt.Errorf("phase %d (%s): no sequences", i, phase.Name)
+		}
+		for j, spec := range phase.Sequences {
+			if err := spec.validate(); err != nil {
+				return fmt.Errorf("phase %d (%s) sequence %d: %w", i, phase.Name, j, err)
+			}
+		}
+		total += len(phase.Sequences)
+	}
+	if total == 0 {
+		return errors.New("no sequences specified")
+	}
+	return nil
+}
+
+// BuildSequences returns the sequences of the scenario, with their clones.
+func (s *Scenario) BuildSequences() ([]Sequence, error) {
+	clock := &scenarioClock{}
+	var sequences []Sequence
+	for i, spec := range s.Sequences {
+		seqs, err := spec.sequences(clock, 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("sequence %d: %w", i, err)
+		}
+		sequences = append(sequences, seqs...)
+	}
+	for i, phase := range s.Phases {
+		for j, spec := range phase.Sequences {
+			seqs, err := spec.sequences(clock, time.Duration(phase.Start), time.Duration(phase.Duration))
+			if err != nil {
+				return nil, fmt.Errorf("phase %d (%s) sequence %d: %w", i, phase.Name, j, err)
+			}
+			sequences = append(sequences, seqs...)
+		}
+	}
+	return sequences, nil
+}
+
+// Options returns the options of Run set by the scenario.
+func (s *Scenario) Options() *Options {
+	opts := DefaultOptions().WithPollTime(time.Duration(s.PollTime))
+	if s.Seed != 0 {
+		opts.WithSeed(s.Seed)
+	}
+	if s.UseFeegrant {
+		opts.UseFeeGrant()
+	}
+	opts.Fill()
+	return opts
+}
+
+func (spec SequenceSpec) validate() error {
+	if spec.Count < 0 {
+		return fmt.Errorf("count can not be negative, got %d", spec.Count)
+	}
+	if spec.Rate < 0 {
+		return fmt.Errorf("rate can not be negative, got %f", spec.Rate)
+	}
+	switch spec.Type {
+	case SequenceTypeBlob:
+		if spec.BlobSizes.Min <= 0 || spec.BlobSizes.Max < spec.BlobSizes.Min {
+			return fmt.Errorf("invalid blob sizes %v", spec.BlobSizes)
+		}
+		if spec.BlobsPerPFB != (Range{}) && (spec.BlobsPerPFB.Min <= 0 || spec.BlobsPerPFB.Max < spec.BlobsPerPFB.Min) {
+			return fmt.Errorf("invalid blobs per PFB %v", spec.BlobsPerPFB)
+		}
+		if spec.Namespace != "" {
+			if _, err := parseNamespace(spec.Namespace); err != nil {
+				return err
+			}
+		}
+	case SequenceTypeSend, SequenceTypeFeegrant, SequenceTypeAuthz, SequenceTypeVesting:
+		if spec.Accounts < 0 || spec.Amount < 0 || spec.Iterations < 0 {
+			return errors.New("accounts, amount and iterations can not be negative")
+		}
+	case SequenceTypeStake:
+		if spec.InitialStake < 0 {
+			return errors.New("initial stake can not be negative")
+		}
+	case SequenceTypeGov:
+		if spec.Voters < 0 || spec.Proposals < 0 {
+			return errors.New("voters and proposals can not be negative")
+		}
+	case SequenceTypeIBCTransfer:
+		if spec.Channel == "" {
+			return errors.New("ibc_transfer sequences require a channel")
+		}
+		if spec.Amount < 0 || spec.Iterations < 0 {
+			return errors.New("amount and iterations can not be negative")
+		}
+	case SequenceTypeCustom:
+		if len(spec.Msgs) == 0 {
+			return errors.New("custom sequences require at least one message")
+		}
+		if _, err := decodeMsgTemplates(spec.Msgs, CustomSequenceAccount); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown sequence type %q", spec.Type)
+	}
+	return nil
+}
+
+// sequences returns the clones of the sequence described by the spec. If start
+// or duration are set, the clones only run during that window.
+func (spec SequenceSpec) sequences(clock *scenarioClock, start, duration time.Duration) ([]Sequence, error) {
+	var sequence Sequence
+	switch spec.Type {
+	case SequenceTypeBlob:
+		blobsPerPFB := spec.BlobsPerPFB
+		if blobsPerPFB == (Range{}) {
+			blobsPerPFB = NewRange(1, 1)
+		}
+		blobSequence := NewBlobSequence(spec.BlobSizes, blobsPerPFB)
+		if spec.Namespace != "" {
+			namespace, err := parseNamespace(spec.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			blobSequence.WithNamespace(namespace)
+		}
+		sequence = blobSequence
+	case SequenceTypeSend:
+		sequence = NewSendSequence(
+			valueOrDefault(spec.Accounts, 2),
+			valueOrDefault(spec.Amount, 1000),
+			valueOrDefault(spec.Iterations, 1000),
+		)
+	case SequenceTypeStake:
+		sequence = NewStakeSequence(valueOrDefault(spec.InitialStake, 1000))
+	case SequenceTypeGov:
+		sequence = NewGovSequence(valueOrDefault(spec.Voters, 2), valueOrDefault(spec.Proposals, 1))
+	case SequenceTypeFeegrant:
+		sequence = NewFeegrantSequence(
+			valueOrDefault(spec.Accounts, 2),
+			valueOrDefault(spec.Amount, 1000),
+			valueOrDefault(spec.Iterations, 1000),
+		)
+	case SequenceTypeAuthz:
+		sequence = NewAuthzSequence(
+			valueOrDefault(spec.Accounts, 2),
+			valueOrDefault(spec.Amount, 1000),
+			valueOrDefault(spec.Iterations, 1000),
+		)
+	case SequenceTypeVesting:
+		sequence = NewVestingSequence(valueOrDefault(spec.Accounts, 100), valueOrDefault(spec.Amount, 1000))
+	case SequenceTypeIBCTransfer:
+		sequence = NewIBCTransferSequence(spec.Channel, valueOrDefault(spec.Amount, 1000), valueOrDefault(spec.Iterations, 1000))
+	case SequenceTypeCustom:
+		sequence = NewCustomSequence(valueOrDefault(spec.Balance, fundsForGas), spec.GasLimit, spec.Msgs...)
+	default:
+		return nil, fmt.Errorf("unknown sequence type %q", spec.Type)
+	}
+
+	clones := sequence.Clone(valueOrDefault(spec.Count, 1))
+	if spec.Rate == 0 && spec.Seed == 0 && start == 0 && duration == 0 {
+		return clones, nil
+	}
+	for i, clone := range clones {
+		clones[i] = &scheduledSequence{
+			sequence: clone,
+			clock:    clock,
+			rate:     spec.Rate,
+			seed:     cloneSeed(spec.Seed, i),
+			start:    start,
+			duration: duration,
+		}
+	}
+	return clones, nil
+}
+
+// cloneSeed returns the seed of the i-th clone of a sequence seeded with seed.
+// A zero seed means that the clones use the randomness of the scenario.
+func cloneSeed(seed int64, i int) int64 {
+	if seed == 0 {
+		return 0
+	}
+	return seed + int64(i)
+}
+
+func valueOrDefault[T int | uint64](value, defaultValue T) T {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func parseNamespace(s string) (ns.Namespace, error) {
+	id, err := hex.DecodeString(s)
+	if err != nil {
+		return ns.Namespace{}, fmt.Errorf("invalid namespace %q: %w", s, err)
+	}
+	if len(id) > ns.NamespaceVersionZeroIDSize {
+		return ns.Namespace{}, fmt.Errorf("invalid namespace %q: at most %d bytes are allowed", s, ns.NamespaceVersionZeroIDSize)
+	}
+	return ns.NewV0(id)
+}
+
+// scenarioClock records the time of the first operation of a scenario, from
+// which the windows of the phases are measured.
+type scenarioClock struct {
+	once  sync.Once
+	start time.Time
+}
+
+func (c *scenarioClock) elapsed() time.Duration {
+	c.once.Do(func() {
+		c.start = time.Now()
+	})
+	return time.Since(c.start)
+}
+
+var _ Sequence = &scheduledSequence{}
+
+// scheduledSequence wraps a sequence of a scenario to limit the rate of its
+// operations, to use its own seed, or to run it during a phase.
+type scheduledSequence struct {
+	sequence Sequence
+	clock    *scenarioClock
+	rate     float64
+	seed     int64
+	start    time.Duration
+	duration time.Duration
+
+	rand   *rand.Rand
+	lastOp time.Time
+}
+
+func (s *scheduledSequence) Clone(n int) []Sequence {
+	clones := s.sequence.Clone(n)
+	for i, clone := range clones {
+		clones[i] = &scheduledSequence{
+			sequence: clone,
+			clock:    s.clock,
+			rate:     s.rate,
+			seed:     cloneSeed(s.seed, i),
+			start:    s.start,
+			duration: s.duration,
+		}
+	}
+	return clones
+}
+
+func (s *scheduledSequence) Init(ctx context.Context, querier grpc.ClientConn, allocateAccounts AccountAllocator, r *rand.Rand, useFeegrant bool) {
+	if s.seed != 0 {
+		s.rand = rand.New(rand.NewSource(s.seed))
+		r = s.rand
+	}
+	s.sequence.Init(ctx, querier, allocateAccounts, r, useFeegrant)
+}
+
+func (s *scheduledSequence) IndependentOperations() bool {
+	return hasIndependentOperations(s.sequence)
+}
+
+func (s *scheduledSequence) Next(ctx context.Context, querier grpc.ClientConn, r *rand.Rand) (Operation, error) {
+	if s.rand != nil {
+		r = s.rand
+	}
+	elapsed := s.clock.elapsed()
+	if s.duration != 0 && elapsed >= s.start+s.duration {
+		return Operation{}, ErrEndOfSequence
+	}
+	// wait for the start of the phase, then for the interval between
+	// operations imposed by the rate
+	wait := s.start - elapsed
+	if s.rate > 0 && !s.lastOp.IsZero() {
+		interval := time.Duration(float64(time.Second) / s.rate)
+		wait = max(wait, time.Until(s.lastOp.Add(interval)))
+	}
+	if err := sleep(ctx, wait); err != nil {
+		return Operation{}, err
+	}
+	if s.duration != 0 && s.clock.elapsed() >= s.start+s.duration {
+		return Operation{}, ErrEndOfSequence
+	}
+	s.lastOp = time.Now()
+	return s.sequence.Next(ctx, querier, r)
+}
+
+// sleep waits for d or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Duration is a time.Duration that is written in scenarios as a string such as
+// "1m30s", or as a number of seconds.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return err
+	}
+	switch value := value.(type) {
+	case string:
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*d = Duration(duration)
+	case float64:
+		*d = Duration(value * float64(time.Second))
+	default:
+		return fmt.Errorf("invalid duration %s", bz)
+	}
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON decodes a range written as "min-max", as a single number for
+// an exact value, or as an object with min and max fields.
+func (r *Range) UnmarshalJSON(bz []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return err
+	}
+	switch value := value.(type) {
+	case float64:
+		*r = NewRange(int(value), int(value))
+	case string:
+		bounds := strings.Split(value, "-")
+		if len(bounds) > 2 {
+			return fmt.Errorf("invalid range %q", value)
+		}
+		min, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return fmt.Errorf("invalid range %q: %w", value, err)
+		}
+		max := min
+		if len(bounds) == 2 {
+			max, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				return fmt.Errorf("invalid range %q: %w", value, err)
+			}
+		}
+		*r = NewRange(min, max)
+	case map[string]interface{}:
+		var bounds struct {
+			Min int `json:"min"`
+			Max int `json:"max"`
+		}
+		if err := json.Unmarshal(bz, &bounds); err != nil {
+			return err
+		}
+		*r = NewRange(bounds.Min, bounds.Max)
+	default:
+		return fmt.Errorf("invalid range %s", bz)
+	}
+	return nil
+}
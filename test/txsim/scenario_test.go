@@ -0,0 +1,240 @@
+package txsim
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const yamlScenario = `
+name: mixed
+seed: 1234
+poll_time: 500ms
+sequences:
+  - type: blob
+    count: 3
+    blob_sizes: 1000-10000
+    blobs_per_pfb: 2
+    namespace: "0102"
+  - type: send
+    count: 2
+    amount: 10
+  - type: stake
+    rate: 0.5
+  - type: custom
+    count: 2
+    msgs:
+      - "@type": /cosmos.bank.v1beta1.MsgSend
+        from_address: $account
+        to_address: celestia1xnt6dg5uj6hljxuhnvsgjm4exs5yxjgmml6j2f
+        amount: [{denom: utia, amount: "1"}]
+phases:
+  - name: burst
+    start: 1m
+    duration: 30
+    sequences:
+      - type: blob
+        count: 4
+        blob_sizes: {min: 100, max: 200}
+        seed: 42
+`
+
+const jsonScenario = `{
+	"name": "blobs",
+	"use_feegrant": true,
+	"sequences": [{"type": "blob", "blob_sizes": "100"}]
+}`
+
+func TestParseScenario(t *testing.T) {
+	scenario, err := ParseScenario([]byte(yamlScenario))
+	require.NoError(t, err)
+	assert.Equal(t, "mixed", scenario.Name)
+	assert.Equal(t, Duration(500*time.Millisecond), scenario.PollTime)
+	require.Len(t, scenario.Sequences, 4)
+	assert.Equal(t, NewRange(1000, 10000), scenario.Sequences[0].BlobSizes)
+	assert.Equal(t, NewRange(2, 2), scenario.Sequences[0].BlobsPerPFB)
+	require.Len(t, scenario.Phases, 1)
+	assert.Equal(t, Duration(time.Minute), scenario.Phases[0].Start)
+	assert.Equal(t, Duration(30*time.Second), scenario.Phases[0].Duration)
+	assert.Equal(t, NewRange(100, 200), scenario.Phases[0].Sequences[0].BlobSizes)
+
+	sequences, err := scenario.BuildSequences()
+	require.NoError(t, err)
+	require.Len(t, sequences, 3+2+1+2+4)
+	blobSequence, ok := sequences[0].(*BlobSequence)
+	require.True(t, ok)
+	assert.Equal(t, []byte{1, 2}, blobSequence.namespace.ID[len(blobSequence.namespace.ID)-2:])
+	assert.IsType(t, &SendSequence{}, sequences[3])
+	assert.IsType(t, &scheduledSequence{}, sequences[5])
+	assert.IsType(t, &CustomSequence{}, sequences[6])
+	phased, ok := sequences[8].(*scheduledSequence)
+	require.True(t, ok)
+	assert.Equal(t, time.Minute, phased.start)
+	assert.Equal(t, int64(42), phased.seed)
+	// every clone derives its own seed
+	phased, ok = sequences[9].(*scheduledSequence)
+	require.True(t, ok)
+	assert.Equal(t, int64(43), phased.seed)
+
+	opts := scenario.Options()
+	assert.Equal(t, int64(1234), opts.seed)
+	assert.Equal(t, 500*time.Millisecond, opts.pollTime)
+	assert.False(t, opts.useFeeGrant)
+
+	scenario, err = ParseScenario([]byte(jsonScenario))
+	require.NoError(t, err)
+	assert.True(t, scenario.Options().UseFeeGrant().useFeeGrant)
+	assert.Equal(t, int64(DefaultSeed), scenario.Options().seed)
+	sequences, err = scenario.BuildSequences()
+	require.NoError(t, err)
+	require.Len(t, sequences, 1)
+	assert.Equal(t, NewRange(1, 1), sequences[0].(*BlobSequence).blobsPerPFB)
+}
+
+func TestParseScenarioModuleSequences(t *testing.T) {
+	scenario, err := ParseScenario([]byte(`
+sequences:
+  - type: gov
+    voters: 3
+  - type: feegrant
+    count: 2
+    accounts: 4
+  - type: authz
+    iterations: 10
+  - type: vesting
+    amount: 10
+  - type: ibc_transfer
+    channel: channel-0
+`))
+	require.NoError(t, err)
+	sequences, err := scenario.BuildSequences()
+	require.NoError(t, err)
+	require.Len(t, sequences, 6)
+
+	gov, ok := sequences[0].(*GovSequence)
+	require.True(t, ok)
+	assert.Equal(t, 3, gov.numVoters)
+	assert.Equal(t, 1, gov.numProposals)
+	// the clones are independent instances with the same parameters
+	feegrant, ok := sequences[1].(*FeegrantSequence)
+	require.True(t, ok)
+	assert.Equal(t, 4, feegrant.numGrantees)
+	assert.Equal(t, feegrant, sequences[2])
+	assert.NotSame(t, feegrant, sequences[2])
+	authz, ok := sequences[3].(*AuthzSequence)
+	require.True(t, ok)
+	assert.Equal(t, 10, authz.numIterations)
+	vesting, ok := sequences[4].(*VestingSequence)
+	require.True(t, ok)
+	assert.Equal(t, 10, vesting.amount)
+	ibc, ok := sequences[5].(*IBCTransferSequence)
+	require.True(t, ok)
+	assert.Equal(t, "channel-0", ibc.channelID)
+}
+
+func TestLoadScenario(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scenario.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(yamlScenario), 0o600))
+	scenario, err := LoadScenario(path)
+	require.NoError(t, err)
+	assert.Equal(t, "mixed", scenario.Name)
+
+	_, err = LoadScenario(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestParseScenarioErrors(t *testing.T) {
+	testCases := map[string]string{
+		"no sequences":       `name: empty`,
+		"unknown field":      `sequences: [{type: send, unknown: 1}]`,
+		"unknown type":       `sequences: [{type: ibc}]`,
+		"no blob sizes":      `sequences: [{type: blob}]`,
+		"invalid range":      `sequences: [{type: blob, blob_sizes: 10-5}]`,
+		"malformed range":    `sequences: [{type: blob, blob_sizes: 1-2-3}]`,
+		"invalid namespace":  `sequences: [{type: blob, blob_sizes: 10, namespace: xyz}]`,
+		"negative count":     `sequences: [{type: send, count: -1}]`,
+		"negative rate":      `sequences: [{type: send, rate: -1}]`,
+		"invalid duration":   `phases: [{start: 1y, sequences: [{type: send}]}]`,
+		"empty phase":        `phases: [{name: empty}]`,
+		"no custom messages": `sequences: [{type: custom}]`,
+		"unknown message":    `sequences: [{type: custom, msgs: [{"@type": /unknown.Msg}]}]`,
+		"negative voters":    `sequences: [{type: gov, voters: -1}]`,
+		"negative grantees":  `sequences: [{type: authz, accounts: -1}]`,
+		"no ibc channel":     `sequences: [{type: ibc_transfer}]`,
+	}
+	for name, scenario := range testCases {
+		_, err := ParseScenario([]byte(scenario))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestScheduledSequence(t *testing.T) {
+	clock := &scenarioClock{}
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(1))
+
+	// a phase starting after 100ms and lasting 200ms
+	phase := &scheduledSequence{
+		sequence: &countingSequence{},
+		clock:    clock,
+		start:    100 * time.Millisecond,
+		duration: 200 * time.Millisecond,
+	}
+	_, err := phase.Next(ctx, nil, r)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, clock.elapsed(), 100*time.Millisecond)
+	time.Sleep(200 * time.Millisecond)
+	_, err = phase.Next(ctx, nil, r)
+	assert.ErrorIs(t, err, ErrEndOfSequence)
+
+	// a sequence limited to 20 operations per second
+	limited := &scheduledSequence{sequence: &countingSequence{}, clock: clock, rate: 20}
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := limited.Next(ctx, nil, r)
+		require.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 4*50*time.Millisecond)
+
+	// waiting is interrupted by the context
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	late := &scheduledSequence{sequence: &countingSequence{}, clock: clock, start: time.Hour}
+	_, err = late.Next(cancelled, nil, r)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	clones := limited.Clone(3)
+	require.Len(t, clones, 3)
+	for _, clone := range clones {
+		assert.Equal(t, 20.0, clone.(*scheduledSequence).rate)
+	}
+}
+
+// countingSequence is an endless sequence of empty operations.
+type countingSequence struct {
+	count int
+}
+
+func (s *countingSequence) Clone(n int) []Sequence {
+	clones := make([]Sequence, n)
+	for i := range clones {
+		clones[i] = &countingSequence{}
+	}
+	return clones
+}
+
+func (s *countingSequence) Init(context.Context, grpc.ClientConn, AccountAllocator, *rand.Rand, bool) {
+}
+
+func (s *countingSequence) Next(context.Context, grpc.ClientConn, *rand.Rand) (Operation, error) {
+	s.count++
+	return Operation{Msgs: []types.Msg{}}, nil
+}
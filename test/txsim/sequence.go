@@ -30,15 +30,35 @@ type Sequence interface {
 	Next(ctx context.Context, querier grpc.ClientConn, rand *rand.Rand) (Operation, error)
 }
 
+// IndependentSequence is implemented by the sequences whose operations don't
+// depend on the previous ones being committed. In open loop mode, only the
+// operations of these sequences can be in flight concurrently; the operations
+// of the other sequences are submitted one at a time.
+type IndependentSequence interface {
+	Sequence
+	// IndependentOperations returns true if the next operation can be built
+	// and broadcast while the previous ones are not committed.
+	IndependentOperations() bool
+}
+
+// hasIndependentOperations returns true if the sequence declares that its
+// operations are independent.
+func hasIndependentOperations(sequence Sequence) bool {
+	independent, ok := sequence.(IndependentSequence)
+	return ok && independent.IndependentOperations()
+}
+
 // Operation represents a series of messages and blobs that are to be bundled
 // in a single transaction. A delay (in heights) may also be set before the transaction is sent.
 // The gas limit and price can also be set. If left at 0, the DefaultGasLimit will be used.
+// If a fee granter is set, it pays the fee of the transaction through its feegrant allowance.
 type Operation struct {
-	Msgs     []types.Msg
-	Blobs    []*blob.Blob
-	Delay    uint64
-	GasLimit uint64
-	GasPrice float64
+	Msgs       []types.Msg
+	Blobs      []*blob.Blob
+	Delay      uint64
+	GasLimit   uint64
+	GasPrice   float64
+	FeeGranter types.AccAddress
 }
 
 const (
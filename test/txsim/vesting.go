@@ -0,0 +1,91 @@
+package txsim
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/cosmos/cosmos-sdk/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/gogo/protobuf/grpc"
+)
+
+var _ Sequence = &VestingSequence{}
+
+// maxVestingPeriod is the upper bound of the random vesting period of the
+// accounts created by the VestingSequence.
+const maxVestingPeriod = 30 * 24 * time.Hour
+
+// VestingSequence sets up a sequence whereby an account funds new vesting accounts, cycling through the
+// continuous, delayed, periodic and permanently locked vesting schedules. The vesting accounts are created at
+// random addresses which never sign transactions.
+type VestingSequence struct {
+	numAccounts int
+	amount      int
+
+	funder types.AccAddress
+	index  int
+}
+
+func NewVestingSequence(numAccounts, amount int) *VestingSequence {
+	return &VestingSequence{
+		numAccounts: numAccounts,
+		amount:      amount,
+	}
+}
+
+func (s *VestingSequence) Clone(n int) []Sequence {
+	sequenceGroup := make([]Sequence, n)
+	for i := 0; i < n; i++ {
+		sequenceGroup[i] = NewVestingSequence(s.numAccounts, s.amount)
+	}
+	return sequenceGroup
+}
+
+func (s *VestingSequence) Init(_ context.Context, _ grpc.ClientConn, allocateAccounts AccountAllocator, _ *rand.Rand, useFeegrant bool) {
+	funds := fundsForGas
+	if useFeegrant {
+		funds = 1
+	}
+	s.funder = allocateAccounts(1, s.numAccounts*s.amount+funds)[0]
+}
+
+// Next creates a vesting account with the next schedule and a random vesting
+// period.
+func (s *VestingSequence) Next(_ context.Context, _ grpc.ClientConn, rand *rand.Rand) (Operation, error) {
+	if s.index >= s.numAccounts {
+		return Operation{}, ErrEndOfSequence
+	}
+	to := make(types.AccAddress, 20)
+	_, _ = rand.Read(to)
+	amount := types.NewCoins(types.NewInt64Coin(appconsts.BondDenom, int64(s.amount)))
+	start := time.Now().Unix()
+	end := start + 2 + rand.Int63n(int64(maxVestingPeriod.Seconds()))
+
+	var msg types.Msg
+	switch s.index % 4 {
+	case 0:
+		msg = vesting.NewMsgCreateVestingAccount(s.funder, to, amount, start, end, false)
+	case 1:
+		msg = vesting.NewMsgCreateVestingAccount(s.funder, to, amount, start, end, true)
+	case 2:
+		// the amount vests in two periods, the second ending at end
+		periods := []vesting.Period{{Length: end - start, Amount: amount}}
+		if s.amount > 1 {
+			first := types.NewCoins(types.NewInt64Coin(appconsts.BondDenom, int64(s.amount/2)))
+			periods = []vesting.Period{
+				{Length: (end - start) / 2, Amount: first},
+				{Length: end - start - (end-start)/2, Amount: amount.Sub(first...)},
+			}
+		}
+		msg = vesting.NewMsgCreatePeriodicVestingAccount(s.funder, to, start, periods)
+	default:
+		msg = vesting.NewMsgCreatePermanentLockedAccount(s.funder, to, amount)
+	}
+	s.index++
+	return Operation{
+		Msgs:  []types.Msg{msg},
+		Delay: uint64(rand.Int63n(3)),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package txsim
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVestingSequence(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sequence := NewVestingSequence(5, 1)
+	sequence.Init(context.Background(), nil, testAllocator(r), r, false)
+
+	var msgs []string
+	for {
+		op, err := sequence.Next(context.Background(), nil, r)
+		if err == ErrEndOfSequence {
+			break
+		}
+		require.NoError(t, err)
+		require.Len(t, op.Msgs, 1)
+		require.NoError(t, op.Msgs[0].ValidateBasic())
+		msgs = append(msgs, types.MsgTypeURL(op.Msgs[0]))
+	}
+	assert.Equal(t, []string{
+		types.MsgTypeURL(&vesting.MsgCreateVestingAccount{}),
+		types.MsgTypeURL(&vesting.MsgCreateVestingAccount{}),
+		types.MsgTypeURL(&vesting.MsgCreatePeriodicVestingAccount{}),
+		types.MsgTypeURL(&vesting.MsgCreatePermanentLockedAccount{}),
+		types.MsgTypeURL(&vesting.MsgCreateVestingAccount{}),
+	}, msgs)
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/das"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/tendermint/tendermint/rpc/client/http"
+)
+
+func main() {
+	if err := Run(); err != nil {
+		log.Println("ERR:", err)
+		os.Exit(1)
+	}
+}
+
+func Run() error {
+	var (
+		squareSizes  = flag.String("square-sizes", "8,16,32,64,128", "comma separated widths of the original data squares filled with random shares")
+		rpcAddress   = flag.String("rpc", "", "RPC address of a node to simulate sampling the square of a block instead of random squares")
+		height       = flag.Int64("height", 0, "height of the block to sample when using -rpc, defaults to the latest height")
+		clients      = flag.Int("clients", 1000, "number of simulated light clients")
+		sampleCounts = flag.String("samples", "1,2,4,8,16,32,64", "comma separated numbers of samples per client")
+		strategies   = flag.String("strategies", "unrecoverable", "comma separated withholding strategies: none, unrecoverable, random:<fraction>, rows:<count>")
+		recoverable  = flag.Bool("check-recoverable", true, "report whether the withholding makes the square unrecoverable")
+		seed         = flag.Int64("seed", 1, "seed of the simulation")
+		output       = flag.String("output", "text", "output format: text or json")
+	)
+	flag.Parse()
+
+	samples, err := parseInts(*sampleCounts)
+	if err != nil {
+		return err
+	}
+	var withholding []das.WithholdingStrategy
+	for _, name := range strings.Split(*strategies, ",") {
+		strategy, err := das.ParseStrategy(strings.TrimSpace(name))
+		if err != nil {
+			return err
+		}
+		withholding = append(withholding, strategy)
+	}
+
+	var squares []*rsmt2d.ExtendedDataSquare
+	if *rpcAddress != "" {
+		eds, err := blockSquare(context.Background(), *rpcAddress, *height)
+		if err != nil {
+			return err
+		}
+		squares = append(squares, eds)
+	} else {
+		sizes, err := parseInts(*squareSizes)
+		if err != nil {
+			return err
+		}
+		for _, size := range sizes {
+			if size > appconsts.SquareSizeUpperBound(appconsts.LatestVersion) {
+				log.Printf("square size %d is above the upper bound %d", size, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+			}
+			eds, err := da.ExtendShares(testfactory.GenerateRandNamespacedRawData(size * size))
+			if err != nil {
+				return err
+			}
+			squares = append(squares, eds)
+		}
+	}
+
+	var results []das.Result
+	for _, eds := range squares {
+		dah, err := da.NewDataAvailabilityHeader(eds)
+		if err != nil {
+			return err
+		}
+		simulator, err := das.NewSimulator(eds, dah)
+		if err != nil {
+			return err
+		}
+		for _, strategy := range withholding {
+			result, err := simulator.Run(das.Config{
+				Clients:          *clients,
+				SampleCounts:     samples,
+				Strategy:         strategy,
+				CheckRecoverable: *recoverable,
+				Seed:             *seed,
+			})
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+	}
+
+	switch *output {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(results)
+	case "text":
+		return printResults(results)
+	default:
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+}
+
+// blockSquare fetches the block at the provided height and constructs its
+// extended data square.
+func blockSquare(ctx context.Context, rpcAddress string, height int64) (*rsmt2d.ExtendedDataSquare, error) {
+	client, err := http.New(rpcAddress, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+	var h *int64
+	if height != 0 {
+		h = &height
+	}
+	block, err := client.Block(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	appVersion := block.Block.Header.Version.App
+	dataSquare, err := square.Construct(block.Block.Data.Txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("sampling the square of size %d of block %d", dataSquare.Size(), block.Block.Height)
+	return da.ExtendShares(shares.ToBytes(dataSquare))
+}
+
+func printResults(results []das.Result) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SQUARE SIZE\tSTRATEGY\tWITHHELD\tRECOVERABLE\tSAMPLES\tDETECTED\tPROBABILITY\tEXPECTED")
+	for _, result := range results {
+		recoverable := "-"
+		if result.Recoverable != nil {
+			recoverable = strconv.FormatBool(*result.Recoverable)
+		}
+		for _, detection := range result.Detections {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%d\t%d/%d\t%.4f\t%.4f\n",
+				result.SquareSize,
+				result.Strategy,
+				result.Withheld,
+				recoverable,
+				detection.Samples,
+				detection.Detected,
+				result.Clients,
+				detection.Probability,
+				detection.ExpectedProbability,
+			)
+		}
+	}
+	return w.Flush()
+}
+
+func parseInts(s string) ([]int, error) {
+	var values []int
+	for _, field := range strings.Split(s, ",") {
+		value, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, err
+		}
+		if value <= 0 {
+			return nil, errors.New("values must be positive")
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/tendermint/tendermint/rpc/client/http"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		log.Println("ERR:", err)
+		os.Exit(1)
+	}
+}
+
+func Run(ctx context.Context) error {
+	var (
+		rpcAddress = flag.String("rpc", "http://localhost:26657", "RPC address of the node to fetch the blocks from")
+		fromHeight = flag.Int64("from", 0, "first height of the range, defaults to the 100 blocks before -to")
+		toHeight   = flag.Int64("to", 0, "last height of the range (inclusive), defaults to the latest height")
+		output     = flag.String("output", "text", "output format: text, csv or json")
+		table      = flag.String("table", "blocks", "table written with -output csv: blocks or namespaces")
+	)
+	flag.Parse()
+
+	client, err := http.New(*rpcAddress, "/websocket")
+	if err != nil {
+		return err
+	}
+	if *toHeight == 0 {
+		status, err := client.Status(ctx)
+		if err != nil {
+			return err
+		}
+		*toHeight = status.SyncInfo.LatestBlockHeight
+	}
+	if *fromHeight == 0 {
+		*fromHeight = max(1, *toHeight-99)
+	}
+	if *fromHeight > *toHeight {
+		return fmt.Errorf("from height %d must be less or equal to the to height %d", *fromHeight, *toHeight)
+	}
+	log.Printf("scanning the squares from height %d to %d", *fromHeight, *toHeight)
+
+	blocks := make([]BlockStats, 0, *toHeight-*fromHeight+1)
+	for height := *fromHeight; height <= *toHeight; height++ {
+		block, err := client.Block(ctx, &height)
+		if err != nil {
+			return err
+		}
+		layout, err := app.NewSquareLayout(block.Block.Data, block.Block.Header.Version.App)
+		if err != nil {
+			return fmt.Errorf("rebuilding the square at height %d: %w", height, err)
+		}
+		layout.Height = height
+		blocks = append(blocks, NewBlockStats(layout))
+	}
+	report := NewReport(blocks)
+
+	switch *output {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	case "csv":
+		switch *table {
+		case "blocks":
+			return writeBlocksCSV(os.Stdout, report.Blocks)
+		case "namespaces":
+			return writeNamespacesCSV(os.Stdout, report.Namespaces)
+		default:
+			return fmt.Errorf("unknown table %q", *table)
+		}
+	case "text":
+		return printReport(report)
+	default:
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+}
+
+func writeBlocksCSV(w io.Writer, blocks []BlockStats) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{
+		"height", "app_version", "square_size", "min_square_size", "total_shares",
+		"tx_shares", "pfb_shares", "blob_shares", "namespace_padding_shares",
+		"reserved_padding_shares", "tail_padding_shares", "txs", "blobs",
+		"blob_bytes", "utilization", "fragmentation",
+	})
+	if err != nil {
+		return err
+	}
+	for _, block := range blocks {
+		err := writer.Write([]string{
+			strconv.FormatInt(block.Height, 10),
+			strconv.FormatUint(block.AppVersion, 10),
+			strconv.Itoa(block.SquareSize),
+			strconv.Itoa(block.MinSquareSize),
+			strconv.Itoa(block.TotalShares),
+			strconv.Itoa(block.TxShares),
+			strconv.Itoa(block.PFBShares),
+			strconv.Itoa(block.BlobShares),
+			strconv.Itoa(block.NamespacePaddingShares),
+			strconv.Itoa(block.ReservedPaddingShares),
+			strconv.Itoa(block.TailPaddingShares),
+			strconv.Itoa(block.Txs),
+			strconv.Itoa(block.Blobs),
+			strconv.Itoa(block.BlobBytes),
+			strconv.FormatFloat(block.Utilization, 'f', 4, 64),
+			strconv.FormatFloat(block.Fragmentation, 'f', 4, 64),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeNamespacesCSV(w io.Writer, namespaces []NamespaceShares) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"namespace", "blocks", "blobs", "blob_bytes", "blob_shares", "padding_shares"}); err != nil {
+		return err
+	}
+	for _, ns := range namespaces {
+		err := writer.Write([]string{
+			ns.Namespace,
+			strconv.Itoa(ns.Blocks),
+			strconv.Itoa(ns.Blobs),
+			strconv.Itoa(ns.BlobBytes),
+			strconv.Itoa(ns.BlobShares),
+			strconv.Itoa(ns.PaddingShares),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func printReport(report Report) error {
+	summary := report.Summary
+	fmt.Printf(`
+Blocks: %d
+Utilization: %.2f%% (%d of %d shares used)
+Alignment padding: %d shares, %.2f%% of the blob shares region
+Tail padding: %d shares
+Blob data: %d bytes
+Average square size: %.2f (%.2f without alignment), %d squares larger than needed
+`,
+		summary.Blocks,
+		100*summary.Utilization, summary.UsedShares, summary.TotalShares,
+		summary.AlignmentPadding, 100*summary.Fragmentation,
+		summary.TailPadding,
+		summary.BlobBytes,
+		summary.AverageSquareSize, summary.AverageMinimumSize, summary.OversizedSquares,
+	)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\nSQUARE SIZE\tBLOCKS")
+	sizes := make([]int, 0, len(summary.SquareSizes))
+	for size := range summary.SquareSizes {
+		sizes = append(sizes, size)
+	}
+	sort.Ints(sizes)
+	for _, size := range sizes {
+		fmt.Fprintf(w, "%d\t%d\n", size, summary.SquareSizes[size])
+	}
+
+	fmt.Fprintln(w, "\nNAMESPACE\tBLOCKS\tBLOBS\tBLOB BYTES\tBLOB SHARES\tPADDING SHARES")
+	for _, ns := range report.Namespaces {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\n", ns.Namespace, ns.Blocks, ns.Blobs, ns.BlobBytes, ns.BlobShares, ns.PaddingShares)
+	}
+	return w.Flush()
+}
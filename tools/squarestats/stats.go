@@ -0,0 +1,202 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/go-square/square"
+)
+
+// BlockStats describes how efficiently the square of a block is packed.
+type BlockStats struct {
+	Height     int64  `json:"height"`
+	AppVersion uint64 `json:"app_version"`
+	// SquareSize is the width of the original data square chosen by the
+	// proposer and MinSquareSize the width of the smallest square that could
+	// hold the used shares if blobs didn't need to be aligned.
+	SquareSize    int `json:"square_size"`
+	MinSquareSize int `json:"min_square_size"`
+	TotalShares   int `json:"total_shares"`
+	TxShares      int `json:"tx_shares"`
+	PFBShares     int `json:"pfb_shares"`
+	BlobShares    int `json:"blob_shares"`
+	// NamespacePaddingShares and ReservedPaddingShares are the padding shares
+	// inserted before blobs to align them to the subtree roots of their
+	// commitments (ADR-013). TailPaddingShares fill the end of the square.
+	NamespacePaddingShares int `json:"namespace_padding_shares"`
+	ReservedPaddingShares  int `json:"reserved_padding_shares"`
+	TailPaddingShares      int `json:"tail_padding_shares"`
+	Txs                    int `json:"txs"`
+	Blobs                  int `json:"blobs"`
+	BlobBytes              int `json:"blob_bytes"`
+	// Utilization is the fraction of the shares of the square that are used,
+	// and Fragmentation the fraction of the shares of the blob region that are
+	// alignment padding.
+	Utilization   float64           `json:"utilization"`
+	Fragmentation float64           `json:"fragmentation"`
+	Namespaces    []NamespaceShares `json:"namespaces"`
+}
+
+// NamespaceShares is the number of shares occupied by a namespace.
+type NamespaceShares struct {
+	Namespace     string `json:"namespace"`
+	Blocks        int    `json:"blocks,omitempty"`
+	Blobs         int    `json:"blobs"`
+	BlobBytes     int    `json:"blob_bytes"`
+	BlobShares    int    `json:"blob_shares"`
+	PaddingShares int    `json:"padding_shares"`
+}
+
+// Summary aggregates the stats of a range of blocks.
+type Summary struct {
+	Blocks             int         `json:"blocks"`
+	SquareSizes        map[int]int `json:"square_sizes"`
+	TotalShares        int         `json:"total_shares"`
+	UsedShares         int         `json:"used_shares"`
+	AlignmentPadding   int         `json:"alignment_padding_shares"`
+	TailPadding        int         `json:"tail_padding_shares"`
+	BlobBytes          int         `json:"blob_bytes"`
+	Utilization        float64     `json:"utilization"`
+	Fragmentation      float64     `json:"fragmentation"`
+	OversizedSquares   int         `json:"oversized_squares"`
+	AverageSquareSize  float64     `json:"average_square_size"`
+	AverageMinimumSize float64     `json:"average_min_square_size"`
+}
+
+// Report is the output of a scan.
+type Report struct {
+	Summary    Summary           `json:"summary"`
+	Blocks     []BlockStats      `json:"blocks"`
+	Namespaces []NamespaceShares `json:"namespaces"`
+}
+
+// NewBlockStats computes the stats of a block from the layout of its square.
+func NewBlockStats(layout app.SquareLayout) BlockStats {
+	stats := BlockStats{
+		Height:      layout.Height,
+		AppVersion:  layout.AppVersion,
+		SquareSize:  layout.SquareSize,
+		TotalShares: layout.SquareSize * layout.SquareSize,
+		Txs:         len(layout.Txs),
+		Blobs:       len(layout.Blobs),
+	}
+	namespaces := make(map[string]*NamespaceShares)
+	namespace := func(ns string) *NamespaceShares {
+		if namespaces[ns] == nil {
+			namespaces[ns] = &NamespaceShares{Namespace: ns}
+		}
+		return namespaces[ns]
+	}
+	for _, r := range layout.Ranges {
+		shares := r.End - r.Start
+		switch r.Kind {
+		case app.ShareKindTx:
+			stats.TxShares += shares
+		case app.ShareKindPFB:
+			stats.PFBShares += shares
+		case app.ShareKindBlob:
+			stats.BlobShares += shares
+			namespace(r.Namespace).BlobShares += shares
+		case app.ShareKindNamespacePadding:
+			stats.NamespacePaddingShares += shares
+			namespace(r.Namespace).PaddingShares += shares
+		case app.ShareKindReservedPadding:
+			stats.ReservedPaddingShares += shares
+		case app.ShareKindTailPadding:
+			stats.TailPaddingShares += shares
+		}
+	}
+	for _, blob := range layout.Blobs {
+		stats.BlobBytes += blob.Size
+		namespace(blob.Namespace).Blobs++
+		namespace(blob.Namespace).BlobBytes += blob.Size
+	}
+
+	used := stats.used()
+	stats.MinSquareSize = square.Size(used)
+	stats.Utilization = ratio(used, stats.TotalShares)
+	stats.Fragmentation = ratio(stats.alignmentPadding(), stats.BlobShares+stats.alignmentPadding())
+	stats.Namespaces = sortedNamespaces(namespaces)
+	return stats
+}
+
+// used returns the number of shares holding transactions or blobs.
+func (s BlockStats) used() int {
+	return s.TxShares + s.PFBShares + s.BlobShares
+}
+
+// alignmentPadding returns the number of padding shares inserted to align
+// blobs.
+func (s BlockStats) alignmentPadding() int {
+	return s.NamespacePaddingShares + s.ReservedPaddingShares
+}
+
+// NewReport aggregates the stats of blocks.
+func NewReport(blocks []BlockStats) Report {
+	report := Report{
+		Blocks: blocks,
+		Summary: Summary{
+			Blocks:      len(blocks),
+			SquareSizes: make(map[int]int),
+		},
+	}
+	namespaces := make(map[string]*NamespaceShares)
+	var blobRegion, squareSizes, minSquareSizes int
+	for _, block := range blocks {
+		summary := &report.Summary
+		summary.SquareSizes[block.SquareSize]++
+		summary.TotalShares += block.TotalShares
+		summary.UsedShares += block.used()
+		summary.AlignmentPadding += block.alignmentPadding()
+		summary.TailPadding += block.TailPaddingShares
+		summary.BlobBytes += block.BlobBytes
+		if block.MinSquareSize < block.SquareSize {
+			summary.OversizedSquares++
+		}
+		blobRegion += block.BlobShares + block.alignmentPadding()
+		squareSizes += block.SquareSize
+		minSquareSizes += block.MinSquareSize
+
+		for _, ns := range block.Namespaces {
+			if namespaces[ns.Namespace] == nil {
+				namespaces[ns.Namespace] = &NamespaceShares{Namespace: ns.Namespace}
+			}
+			total := namespaces[ns.Namespace]
+			total.Blocks++
+			total.Blobs += ns.Blobs
+			total.BlobBytes += ns.BlobBytes
+			total.BlobShares += ns.BlobShares
+			total.PaddingShares += ns.PaddingShares
+		}
+	}
+	report.Summary.Utilization = ratio(report.Summary.UsedShares, report.Summary.TotalShares)
+	report.Summary.Fragmentation = ratio(report.Summary.AlignmentPadding, blobRegion)
+	report.Summary.AverageSquareSize = ratio(squareSizes, len(blocks))
+	report.Summary.AverageMinimumSize = ratio(minSquareSizes, len(blocks))
+	report.Namespaces = sortedNamespaces(namespaces)
+	return report
+}
+
+// sortedNamespaces returns the namespaces by decreasing number of shares.
+func sortedNamespaces(namespaces map[string]*NamespaceShares) []NamespaceShares {
+	sorted := make([]NamespaceShares, 0, len(namespaces))
+	for _, ns := range namespaces {
+		sorted = append(sorted, *ns)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		si := sorted[i].BlobShares + sorted[i].PaddingShares
+		sj := sorted[j].BlobShares + sorted[j].PaddingShares
+		if si != sj {
+			return si > sj
+		}
+		return sorted[i].Namespace < sorted[j].Namespace
+	})
+	return sorted
+}
+
+func ratio(a, b int) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
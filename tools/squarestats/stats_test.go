@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestNewBlockStats(t *testing.T) {
+	nsA := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	nsB := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+
+	tests := []struct {
+		name       string
+		sends      int
+		namespaces []appns.Namespace
+		sizes      []int
+		want       BlockStats
+	}{
+		{
+			name:  "only transactions",
+			sends: 2,
+			want: BlockStats{
+				SquareSize:        2,
+				MinSquareSize:     2,
+				TotalShares:       4,
+				TxShares:          2,
+				TailPaddingShares: 2,
+				Txs:               2,
+				Utilization:       0.5,
+				Namespaces:        []NamespaceShares{},
+			},
+		},
+		{
+			name:       "single share blob",
+			namespaces: []appns.Namespace{nsA},
+			sizes:      []int{100},
+			want: BlockStats{
+				SquareSize:        2,
+				MinSquareSize:     2,
+				TotalShares:       4,
+				PFBShares:         1,
+				BlobShares:        1,
+				TailPaddingShares: 2,
+				Txs:               1,
+				Blobs:             1,
+				BlobBytes:         100,
+				Utilization:       0.5,
+				Namespaces: []NamespaceShares{
+					{Namespace: hex.EncodeToString(nsA.Bytes()), Blobs: 1, BlobBytes: 100, BlobShares: 1},
+				},
+			},
+		},
+		{
+			// the 65 shares blob has a subtree width of 2 so it must start at
+			// an even index, after one reserved padding share.
+			name:       "large blob after the PFB",
+			namespaces: []appns.Namespace{nsB},
+			sizes:      []int{31000},
+			want: BlockStats{
+				SquareSize:            16,
+				MinSquareSize:         16,
+				TotalShares:           256,
+				PFBShares:             1,
+				BlobShares:            65,
+				ReservedPaddingShares: 1,
+				TailPaddingShares:     189,
+				Txs:                   1,
+				Blobs:                 1,
+				BlobBytes:             31000,
+				Utilization:           66.0 / 256,
+				Fragmentation:         1.0 / 66,
+				Namespaces: []NamespaceShares{
+					{Namespace: hex.EncodeToString(nsB.Bytes()), Blobs: 1, BlobBytes: 31000, BlobShares: 65},
+				},
+			},
+		},
+		{
+			// the large blob of namespace B is aligned by padding the end of
+			// namespace A.
+			name:       "large blob after a small one",
+			namespaces: []appns.Namespace{nsA, nsB},
+			sizes:      []int{100, 31000},
+			want: BlockStats{
+				SquareSize:             16,
+				MinSquareSize:          16,
+				TotalShares:            256,
+				PFBShares:              2,
+				BlobShares:             66,
+				NamespacePaddingShares: 1,
+				TailPaddingShares:      187,
+				Txs:                    2,
+				Blobs:                  2,
+				BlobBytes:              31100,
+				Utilization:            68.0 / 256,
+				Fragmentation:          1.0 / 67,
+				Namespaces: []NamespaceShares{
+					{Namespace: hex.EncodeToString(nsB.Bytes()), Blobs: 1, BlobBytes: 31000, BlobShares: 65},
+					{Namespace: hex.EncodeToString(nsA.Bytes()), Blobs: 1, BlobBytes: 100, BlobShares: 1, PaddingShares: 1},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := testnode.NewOfflineSigner()
+			require.NoError(t, err)
+			txs := blobfactory.GenerateManyRawSendTxs(signer, tt.sends)
+			txs = append(txs, blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, tt.namespaces, tt.sizes)...)
+
+			layout, err := app.NewSquareLayout(coretypes.Data{Txs: txs}, appconsts.LatestVersion)
+			require.NoError(t, err)
+
+			want := tt.want
+			want.AppVersion = appconsts.LatestVersion
+			assert.Equal(t, want, NewBlockStats(layout))
+		})
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	blocks := []BlockStats{
+		{
+			SquareSize:             4,
+			MinSquareSize:          2,
+			TotalShares:            16,
+			TxShares:               1,
+			PFBShares:              1,
+			BlobShares:             1,
+			NamespacePaddingShares: 1,
+			TailPaddingShares:      12,
+			BlobBytes:              100,
+			Namespaces: []NamespaceShares{
+				{Namespace: "a", Blobs: 1, BlobBytes: 100, BlobShares: 1, PaddingShares: 1},
+			},
+		},
+		{
+			SquareSize:            4,
+			MinSquareSize:         4,
+			TotalShares:           16,
+			PFBShares:             2,
+			BlobShares:            12,
+			ReservedPaddingShares: 2,
+			BlobBytes:             5000,
+			Namespaces: []NamespaceShares{
+				{Namespace: "a", Blobs: 1, BlobBytes: 1000, BlobShares: 3},
+				{Namespace: "b", Blobs: 2, BlobBytes: 4000, BlobShares: 9},
+			},
+		},
+	}
+
+	report := NewReport(blocks)
+	assert.Equal(t, blocks, report.Blocks)
+	assert.Equal(t, Summary{
+		Blocks:             2,
+		SquareSizes:        map[int]int{4: 2},
+		TotalShares:        32,
+		UsedShares:         17,
+		AlignmentPadding:   3,
+		TailPadding:        12,
+		BlobBytes:          5100,
+		Utilization:        17.0 / 32,
+		Fragmentation:      3.0 / 16,
+		OversizedSquares:   1,
+		AverageSquareSize:  4,
+		AverageMinimumSize: 3,
+	}, report.Summary)
+	assert.Equal(t, []NamespaceShares{
+		{Namespace: "b", Blocks: 1, Blobs: 2, BlobBytes: 4000, BlobShares: 9},
+		{Namespace: "a", Blocks: 2, Blobs: 2, BlobBytes: 1100, BlobShares: 4, PaddingShares: 1},
+	}, report.Namespaces)
+}
@@ -0,0 +1,297 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/user"
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/go-square/blob"
+)
+
+const (
+	// FlagMultisig allows the user to sign an unsigned blob tx on behalf of
+	// the multisig account with the provided address.
+	FlagMultisig = "multisig"
+
+	// FlagSignatureOnly allows the user to print only the signature instead
+	// of the signed blob tx.
+	FlagSignatureOnly = "signature-only"
+
+	// FlagOutputDocument allows the user to provide a path where the result
+	// is written instead of stdout.
+	FlagOutputDocument = "output-document"
+)
+
+func CmdSignBlobTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-blob-tx [file]",
+		Short: "Sign an unsigned blob tx generated with pay-for-blob --generate-only.",
+		Long: `Sign an unsigned blob tx generated with pay-for-blob --generate-only.
+The file contains the PayForBlobs transaction along with its blobs, the chain
+ID, the account number and the sequence of the signer so that it can be signed
+on a machine that is not connected to the network.
+
+If the key provided by --from is the signer of the transaction, the signature is
+added to the transaction and the signed blob tx is printed. It can then be
+broadcast with broadcast-blob-tx.
+
+If the signer is a multisig account, provide its address with --multisig. Only
+the signature is printed, using SIGN_MODE_LEGACY_AMINO_JSON unless another sign
+mode is requested. The signatures of the members are combined with
+multisign-blob-tx.
+`,
+		Example: "celestia-appd tx blob pay-for-blob 0x00010203040506070809 0x48656c6c6f2c20576f726c6421 \\\n" +
+			"\t--from multisig --generate-only > unsigned.json\n" +
+			"celestia-appd tx blob sign-blob-tx unsigned.json --from k1 --multisig celestia1... \\\n" +
+			"\t--output-document k1sig.json\n",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			utx, err := readUnsignedBlobTx(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			if clientCtx.ChainID != "" && clientCtx.ChainID != utx.ChainID {
+				return fmt.Errorf("blob tx is for chain %s, not %s", utx.ChainID, clientCtx.ChainID)
+			}
+
+			multisigAddr, err := cmd.Flags().GetString(FlagMultisig)
+			if err != nil {
+				return err
+			}
+			signatureOnly, err := cmd.Flags().GetBool(FlagSignatureOnly)
+			if err != nil {
+				return err
+			}
+
+			signMode := sdktx.NewFactoryCLI(clientCtx, cmd.Flags()).SignMode()
+			if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+				signMode = signing.SignMode_SIGN_MODE_DIRECT
+				if multisigAddr != "" {
+					signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+				}
+			}
+
+			signer, err := utx.SignerAddress(clientCtx.TxConfig)
+			if err != nil {
+				return err
+			}
+			if multisigAddr != "" {
+				addr, err := sdk.AccAddressFromBech32(multisigAddr)
+				if err != nil {
+					return err
+				}
+				if !addr.Equals(signer) {
+					return fmt.Errorf("blob tx must be signed by %s, not %s", signer, addr)
+				}
+				signatureOnly = true
+			}
+
+			sig, err := utx.Sign(clientCtx.TxConfig, clientCtx.Keyring, clientCtx.GetFromName(), signMode)
+			if err != nil {
+				return err
+			}
+
+			if signatureOnly {
+				bz, err := clientCtx.TxConfig.MarshalSignatureJSON([]signing.SignatureV2{sig})
+				if err != nil {
+					return err
+				}
+				return writeOutputDocument(cmd, bz)
+			}
+
+			if !signer.Equals(sdk.AccAddress(sig.PubKey.Address())) {
+				return fmt.Errorf("blob tx must be signed by %s, use --%s to sign on behalf of a multisig", signer, FlagMultisig)
+			}
+			if err := utx.SetSignatures(clientCtx.TxConfig, sig); err != nil {
+				return err
+			}
+			return writeUnsignedBlobTx(cmd, utx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagMultisig, "", "Address of the multisig account the signature is created on behalf of")
+	cmd.Flags().Bool(FlagSignatureOnly, false, "Print only the signature")
+	cmd.Flags().String(FlagOutputDocument, "", "The document is written to the given file instead of stdout")
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
+func CmdMultisignBlobTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-blob-tx [file] [multisig-key-name] [signature-file]...",
+		Short: "Combine the signatures of the members of a multisig into an unsigned blob tx.",
+		Long: `Combine the signatures of the members of a multisig into an unsigned blob tx.
+The signatures are produced with sign-blob-tx --multisig, or with
+tx sign --signature-only on the tx field of the file. The multisig key must be
+stored in the keyring, see keys add --multisig. The signed blob tx is printed
+and can be broadcast with broadcast-blob-tx.
+`,
+		Example: "celestia-appd tx blob multisign-blob-tx unsigned.json multisig k1sig.json k2sig.json \\\n" +
+			"\t--output-document signed.json\n",
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			utx, err := readUnsignedBlobTx(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			record, err := clientCtx.Keyring.Key(args[1])
+			if err != nil {
+				return fmt.Errorf("retrieving multisig key %s: %w", args[1], err)
+			}
+			multisigPub, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			var sigs []signing.SignatureV2
+			for _, path := range args[2:] {
+				bz, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				fileSigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+				if err != nil {
+					return fmt.Errorf("decoding signatures in %s: %w", path, err)
+				}
+				sigs = append(sigs, fileSigs...)
+			}
+
+			sig, err := utx.CombineMultisigSignatures(clientCtx.TxConfig, multisigPub, sigs...)
+			if err != nil {
+				return err
+			}
+			if err := utx.SetSignatures(clientCtx.TxConfig, sig); err != nil {
+				return err
+			}
+			return writeUnsignedBlobTx(cmd, utx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagOutputDocument, "", "The document is written to the given file instead of stdout")
+	return cmd
+}
+
+func CmdBroadcastBlobTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast-blob-tx [file]",
+		Short: "Broadcast a blob tx signed with sign-blob-tx or multisign-blob-tx.",
+		Long: `Broadcast a blob tx signed with sign-blob-tx or multisign-blob-tx.
+The signed transaction is wrapped with its blobs into a BlobTx and broadcast to
+the node provided by --node.
+`,
+		Example: "celestia-appd tx blob broadcast-blob-tx signed.json --chain-id private\n",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			utx, err := readUnsignedBlobTx(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			blobTx, err := utx.MarshalBlobTx(clientCtx.TxConfig)
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(blobTx)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// generateUnsignedBlobTx builds an unsigned blob tx for the PFB so that it can
+// be signed offline. Unless the client is offline, the account number and
+// sequence of the signer are queried if they weren't provided.
+func generateUnsignedBlobTx(clientCtx client.Context, txf sdktx.Factory, pfbMsg *types.MsgPayForBlobs, blobs []*blob.Blob) (*user.UnsignedBlobTx, error) {
+	if !clientCtx.Offline {
+		var err error
+		txf, err = txf.Prepare(clientCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := txf.BuildUnsignedTx(pfbMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.NewUnsignedBlobTx(clientCtx.TxConfig, tx.GetTx(), blobs, txf.ChainID(), txf.AccountNumber(), txf.Sequence())
+}
+
+// withEstimatedGas sets the gas limit of the PFB, and if setFees is true the
+// fee, to values derived from the blob sizes.
+func withEstimatedGas(txf sdktx.Factory, blobSizes []uint32, setFees bool) sdktx.Factory {
+	gas := uint64(float64(types.DefaultEstimateGas(blobSizes)) * gasMultiplier)
+	txf = txf.WithGas(gas)
+	if setFees {
+		fee := int64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gas)))
+		txf = txf.WithFees(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, fee)).String())
+	}
+	return txf
+}
+
+func readUnsignedBlobTx(clientCtx client.Context, path string) (*user.UnsignedBlobTx, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return user.UnmarshalUnsignedBlobTx(clientCtx.TxConfig, bz)
+}
+
+func writeUnsignedBlobTx(cmd *cobra.Command, utx *user.UnsignedBlobTx) error {
+	bz, err := json.Marshal(utx)
+	if err != nil {
+		return err
+	}
+	return writeOutputDocument(cmd, bz)
+}
+
+// writeOutputDocument writes the document to the file provided by
+// FlagOutputDocument or to stdout.
+func writeOutputDocument(cmd *cobra.Command, bz []byte) error {
+	path, err := cmd.Flags().GetString(FlagOutputDocument)
+	if err != nil {
+		return err
+	}
+	if path == "" {
+		_, err := fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bz)
+		return err
+	}
+	return os.WriteFile(path, bz, 0o600)
+}
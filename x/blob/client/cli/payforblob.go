@@ -38,6 +38,21 @@ const (
 	// FileInputExtension is the only file extension supported for
 	// FlagFileInput.
 	FileInputExtension = ".json"
+
+	// FlagRawInput allows the user to provide raw binary data from a file, a
+	// directory or stdin (by passing StdinInput) for submitting blobs.
+	FlagRawInput = "input-raw"
+
+	// StdinInput is the value of FlagRawInput that reads raw data from stdin.
+	StdinInput = "-"
+
+	// FlagMaxBlobSize allows the user to cap the number of bytes in each blob
+	// when raw input is split into several blobs.
+	FlagMaxBlobSize = "max-blob-size"
+
+	// FlagManifest allows the user to provide a path where the manifest
+	// describing how raw input was split into blobs is written.
+	FlagManifest = "manifest"
 )
 
 func CmdPayForBlob() *cobra.Command {
@@ -54,6 +69,12 @@ func CmdPayForBlob() *cobra.Command {
 			"\t--from validator \\\n" +
 			"\t--keyring-backend test \\\n" +
 			"\t--fees 21000utia \\\n" +
+			"\t--yes \n\n" +
+			"cat batch.bin | celestia-appd tx blob pay-for-blob 0x00010203040506070809 --input-raw - \\\n" +
+			"\t--chain-id private \\\n" +
+			"\t--from validator \\\n" +
+			"\t--keyring-backend test \\\n" +
+			"\t--manifest path/to/manifest.json \\\n" +
 			"\t--yes \n",
 		Short: "Pay for data blob(s) to be published to Celestia.",
 		Long: `Pay for data blob(s) to be published to Celestia.
@@ -74,6 +95,22 @@ The JSON should look like:
 	]
 }
 
+To publish raw binary data, specify the namespaceID via CLI argument and use
+the --input-raw flag with the path to a file, a directory or "-" to read from
+stdin. Every regular file in a directory is published. Data that does not fit
+in a single blob is split across several blobs and, if needed, several
+PayForBlobs transactions so that each transaction stays within the max number
+of blob shares of a data square. Use --max-blob-size to further limit the size
+of each blob. A manifest listing the share commitment, offset and size of every
+blob is printed to stderr (or written to the path given by --manifest) so that
+the data can be reassembled.
+
+To sign the PayForBlobs offline, for example on an air-gapped machine or by
+the members of a multisig, use --generate-only. The unsigned transaction is
+printed along with its blobs and can be signed with sign-blob-tx (and
+multisign-blob-tx) and broadcast with broadcast-blob-tx. With --input-raw, one
+unsigned transaction is printed per PayForBlobs with consecutive sequences.
+
 The namespaceID is the user-specifiable portion of a version 0 namespace.
 The namespaceID must be a hex encoded string of 10 bytes.
 The blob must be a hex encoded string of non-zero length.
@@ -85,6 +122,22 @@ The blob must be a hex encoded string of non-zero length.
 				return err
 			}
 
+			rawPath, err := cmd.Flags().GetString(FlagRawInput)
+			if err != nil {
+				return err
+			}
+
+			if rawPath != "" {
+				if path != "" {
+					return fmt.Errorf("only one of %s and %s can be provided", FlagFileInput, FlagRawInput)
+				}
+				if len(args) != 1 {
+					return fmt.Errorf("pay-for-blob requires exactly one argument if %s is provided: namespaceID", FlagRawInput)
+				}
+
+				return nil
+			}
+
 			if path != "" {
 				if filepath.Ext(path) != FileInputExtension {
 					return fmt.Errorf("invalid file extension %v. The only supported extension is %s", filepath.Ext(path), FileInputExtension)
@@ -115,6 +168,16 @@ The blob must be a hex encoded string of non-zero length.
 				return err
 			}
 
+			rawPath, err := cmd.Flags().GetString(FlagRawInput)
+			if err != nil {
+				return err
+			}
+
+			// In case of raw input, split the data into as many blobs and PFBs as needed
+			if rawPath != "" {
+				return broadcastRawInput(cmd, args[0], rawPath, namespaceVersion, shareVersion)
+			}
+
 			// In case of no file input, get the namespaceID and blob from the arguments
 			if path == "" {
 				blob, err := getBlobFromArguments(args[0], args[1], namespaceVersion, shareVersion)
@@ -147,6 +210,10 @@ The blob must be a hex encoded string of non-zero length.
 	cmd.PersistentFlags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
 	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version (default 0)")
 	cmd.PersistentFlags().String(FlagFileInput, "", "Specify the file input")
+	cmd.PersistentFlags().String(FlagRawInput, "", "Specify a file, a directory or \"-\" for stdin to publish as raw blob data")
+	cmd.PersistentFlags().Int(FlagMaxBlobSize, 0, "Specify the max number of bytes per blob when splitting raw input (default derived from the max square size)")
+	cmd.PersistentFlags().String(FlagManifest, "", "Specify the path to write the raw input manifest to (default stderr)")
+	cmd.PersistentFlags().String(FlagOutputDocument, "", "Specify the path to write the unsigned blob tx to when using --generate-only (default stdout)")
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 	return cmd
 }
@@ -209,7 +276,20 @@ func broadcastPFB(cmd *cobra.Command, b ...*blob.Blob) error {
 		return err
 	}
 
-	txBytes, err := writeTx(clientCtx, sdktx.NewFactoryCLI(clientCtx, cmd.Flags()), pfbMsg)
+	txf := sdktx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if clientCtx.GenerateOnly {
+		if !cmd.Flags().Changed(flags.FlagGas) {
+			setFees := !cmd.Flags().Changed(flags.FlagFees) && !cmd.Flags().Changed(flags.FlagGasPrices)
+			txf = withEstimatedGas(txf, pfbMsg.BlobSizes, setFees)
+		}
+		utx, err := generateUnsignedBlobTx(clientCtx, txf, pfbMsg, b)
+		if err != nil {
+			return err
+		}
+		return writeUnsignedBlobTx(cmd, utx)
+	}
+
+	txBytes, err := writeTx(clientCtx, txf, pfbMsg)
 	if err != nil {
 		return err
 	}
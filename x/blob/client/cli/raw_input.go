@@ -0,0 +1,441 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/user"
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/go-square/blob"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+const (
+	// stdinSourceName is the name used in the manifest for data read from
+	// stdin.
+	stdinSourceName = "stdin"
+
+	// gasMultiplier is applied to the default gas estimation of a PFB if the
+	// user did not provide a gas limit.
+	gasMultiplier = 1.1
+)
+
+// rawSource is a named stream of raw bytes that is split into blobs.
+type rawSource struct {
+	name string
+	open func() (io.ReadCloser, error)
+}
+
+// rawManifest describes how raw input was split into blobs so that the
+// original data can be reassembled from the blobs published on chain. Every
+// source is reassembled by concatenating the data of its blobs ordered by
+// offset.
+type rawManifest struct {
+	// Namespace is the hex encoded namespace that all blobs were published to.
+	Namespace    string         `json:"namespace"`
+	ShareVersion uint8          `json:"share_version"`
+	Files        []manifestFile `json:"files"`
+	Blobs        []manifestBlob `json:"blobs"`
+}
+
+// manifestFile describes a single source of raw input.
+type manifestFile struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// manifestBlob describes a single blob and the part of a source it contains.
+type manifestBlob struct {
+	// File is the index of the source in the manifest files.
+	File   int   `json:"file"`
+	Offset int64 `json:"offset"`
+	Size   int   `json:"size"`
+	// Commitment is the hex encoded share commitment of the blob.
+	Commitment string `json:"commitment"`
+	// TxHash is the hash of the PFB that paid for the blob. It is empty if
+	// the transaction was not broadcast.
+	TxHash string `json:"tx_hash,omitempty"`
+	// Height is the height the PFB was included at. It is zero if the
+	// transaction was not broadcast in block mode.
+	Height int64 `json:"height,omitempty"`
+	// BlobIndex is the index of the blob in the PFB.
+	BlobIndex int `json:"blob_index"`
+}
+
+// pfbResult is the outcome of submitting a single PFB.
+type pfbResult struct {
+	txHash      string
+	height      int64
+	commitments [][]byte
+}
+
+// blobSplitter splits raw data into blobs and groups the blobs into PFBs that
+// fit in a data square.
+type blobSplitter struct {
+	namespace            appns.Namespace
+	shareVersion         uint8
+	squareSize           int
+	subtreeRootThreshold int
+	maxBlobSize          int
+}
+
+// newBlobSplitter returns a blobSplitter for the max effective square size
+// derived from the governance max square size and the app version. If
+// maxBlobSize is zero, the largest blob that fits in a square alongside its
+// PFB is used.
+func newBlobSplitter(namespace appns.Namespace, shareVersion uint8, govMaxSquareSize, appVersion uint64, maxBlobSize int) (blobSplitter, error) {
+	if maxBlobSize < 0 {
+		return blobSplitter{}, fmt.Errorf("max blob size %d cannot be negative", maxBlobSize)
+	}
+	s := blobSplitter{
+		namespace:            namespace,
+		shareVersion:         shareVersion,
+		squareSize:           min(appconsts.SquareSizeUpperBound(appVersion), int(govMaxSquareSize)),
+		subtreeRootThreshold: appconsts.SubtreeRootThreshold(appVersion),
+	}
+
+	upperBound := types.LargestBlobSize(s.squareSize, s.subtreeRootThreshold)
+	if upperBound == 0 {
+		return blobSplitter{}, fmt.Errorf("square size %d is too small to fit a blob", s.squareSize)
+	}
+	switch {
+	case maxBlobSize == 0:
+		s.maxBlobSize = upperBound
+	case maxBlobSize > upperBound:
+		return blobSplitter{}, fmt.Errorf("max blob size %d exceeds the largest blob size %d that fits in a square of size %d", maxBlobSize, upperBound, s.squareSize)
+	default:
+		s.maxBlobSize = maxBlobSize
+	}
+	return s, nil
+}
+
+// fits returns true if a PFB paying for blobs of the provided sizes can be
+// included in a square.
+func (s blobSplitter) fits(blobSizes ...int) bool {
+	return types.BlobsFitInSquare(s.squareSize, s.subtreeRootThreshold, blobSizes...)
+}
+
+// split reads every source in chunks of at most maxBlobSize bytes, turns
+// each chunk into a blob and calls submit with groups of blobs that fit in a
+// single PFB. It returns the manifest describing the published blobs.
+func (s blobSplitter) split(sources []rawSource, submit func([]*blob.Blob) (pfbResult, error)) (rawManifest, error) {
+	manifest := rawManifest{
+		Namespace:    hex.EncodeToString(s.namespace.Bytes()),
+		ShareVersion: s.shareVersion,
+		Files:        make([]manifestFile, 0, len(sources)),
+		Blobs:        []manifestBlob{},
+	}
+
+	var (
+		pending      []*blob.Blob
+		pendingSizes []int
+		pendingInfo  []manifestBlob
+	)
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		res, err := submit(pending)
+		if err != nil {
+			return err
+		}
+		if len(res.commitments) != len(pending) {
+			return fmt.Errorf("expected %d share commitments, got %d", len(pending), len(res.commitments))
+		}
+		for i, info := range pendingInfo {
+			info.Commitment = hex.EncodeToString(res.commitments[i])
+			info.TxHash = res.txHash
+			info.Height = res.height
+			info.BlobIndex = i
+			manifest.Blobs = append(manifest.Blobs, info)
+		}
+		pending, pendingSizes, pendingInfo = nil, nil, nil
+		return nil
+	}
+
+	for fileIndex, source := range sources {
+		reader, err := source.open()
+		if err != nil {
+			return rawManifest{}, err
+		}
+		hasher := sha256.New()
+		tee := io.TeeReader(reader, hasher)
+
+		var offset int64
+		for {
+			chunk := make([]byte, s.maxBlobSize)
+			n, err := io.ReadFull(tee, chunk)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+				_ = reader.Close()
+				return rawManifest{}, fmt.Errorf("reading %s: %w", source.name, err)
+			}
+
+			b, err := types.NewBlob(s.namespace, chunk[:n], s.shareVersion)
+			if err != nil {
+				_ = reader.Close()
+				return rawManifest{}, err
+			}
+			if !s.fits(append(pendingSizes, n)...) {
+				if err := flush(); err != nil {
+					_ = reader.Close()
+					return rawManifest{}, err
+				}
+			}
+			pending = append(pending, b)
+			pendingSizes = append(pendingSizes, n)
+			pendingInfo = append(pendingInfo, manifestBlob{File: fileIndex, Offset: offset, Size: n})
+			offset += int64(n)
+
+			if n < s.maxBlobSize {
+				break
+			}
+		}
+		if err := reader.Close(); err != nil {
+			return rawManifest{}, err
+		}
+
+		manifest.Files = append(manifest.Files, manifestFile{
+			Path:   source.name,
+			Size:   offset,
+			SHA256: hex.EncodeToString(hasher.Sum(nil)),
+		})
+	}
+
+	if len(pending) == 0 && len(manifest.Blobs) == 0 {
+		return rawManifest{}, errors.New("raw input does not contain any data")
+	}
+	if err := flush(); err != nil {
+		return rawManifest{}, err
+	}
+	return manifest, nil
+}
+
+// openRawSources returns the sources of raw input found at path. The path can
+// be a regular file, a directory whose regular files are all used in lexical
+// order or StdinInput to read from stdin.
+func openRawSources(path string, stdin io.Reader) ([]rawSource, error) {
+	if path == StdinInput {
+		return []rawSource{{
+			name: stdinSourceName,
+			open: func() (io.ReadCloser, error) { return io.NopCloser(stdin), nil },
+		}}, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []rawSource{newFileSource(filepath.Base(path), path)}, nil
+	}
+
+	var sources []rawSource
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(path, p)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, newFileSource(filepath.ToSlash(rel), p))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("directory %s does not contain any files", path)
+	}
+	return sources, nil
+}
+
+func newFileSource(name, path string) rawSource {
+	return rawSource{
+		name: name,
+		open: func() (io.ReadCloser, error) { return os.Open(path) },
+	}
+}
+
+// broadcastRawInput splits the raw input found at path into blobs, broadcasts
+// as many PFBs as needed to publish them and writes the resulting manifest.
+func broadcastRawInput(cmd *cobra.Command, namespaceIDArg, path string, namespaceVersion, shareVersion uint8) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	maxBlobSize, err := cmd.Flags().GetInt(FlagMaxBlobSize)
+	if err != nil {
+		return err
+	}
+
+	manifestPath, err := cmd.Flags().GetString(FlagManifest)
+	if err != nil {
+		return err
+	}
+
+	outputDocument, err := cmd.Flags().GetString(FlagOutputDocument)
+	if err != nil {
+		return err
+	}
+	if outputDocument != "" {
+		return fmt.Errorf("%s can't be used with %s as several transactions may be generated", FlagOutputDocument, FlagRawInput)
+	}
+
+	// the confirmation prompt reads from stdin so it can't be used when the
+	// blob data is streamed through stdin
+	if path == StdinInput && !clientCtx.SkipConfirm && !clientCtx.GenerateOnly && !clientCtx.Simulate {
+		return fmt.Errorf("reading blob data from stdin requires --%s", flags.FlagSkipConfirmation)
+	}
+
+	namespaceID, err := hex.DecodeString(strings.TrimPrefix(namespaceIDArg, "0x"))
+	if err != nil {
+		return fmt.Errorf("failed to decode hex namespace ID: %w", err)
+	}
+	namespace, err := getNamespace(namespaceID, namespaceVersion)
+	if err != nil {
+		return err
+	}
+
+	govMaxSquareSize, err := queryGovMaxSquareSize(cmd, clientCtx)
+	if err != nil {
+		return err
+	}
+
+	splitter, err := newBlobSplitter(namespace, shareVersion, govMaxSquareSize, appconsts.LatestVersion, maxBlobSize)
+	if err != nil {
+		return err
+	}
+
+	sources, err := openRawSources(path, cmd.InOrStdin())
+	if err != nil {
+		return err
+	}
+
+	txf := sdktx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if !clientCtx.Offline {
+		txf, err = txf.Prepare(clientCtx)
+		if err != nil {
+			return err
+		}
+	}
+	estimateGas := !cmd.Flags().Changed(flags.FlagGas)
+	setFees := !cmd.Flags().Changed(flags.FlagFees) && !cmd.Flags().Changed(flags.FlagGasPrices)
+
+	submit := func(blobs []*blob.Blob) (pfbResult, error) {
+		pfbMsg, err := types.NewMsgPayForBlobs(clientCtx.FromAddress.String(), appconsts.LatestVersion, blobs...)
+		if err != nil {
+			return pfbResult{}, err
+		}
+
+		// run message checks
+		if err = pfbMsg.ValidateBasic(); err != nil {
+			return pfbResult{}, err
+		}
+
+		f := txf
+		if estimateGas {
+			f = withEstimatedGas(f, pfbMsg.BlobSizes, setFees)
+		}
+		// every PFB uses the next sequence as the previous ones may not have
+		// been committed yet
+		txf = txf.WithSequence(txf.Sequence() + 1)
+
+		result := pfbResult{commitments: pfbMsg.ShareCommitments}
+		if clientCtx.GenerateOnly {
+			tx, err := f.BuildUnsignedTx(pfbMsg)
+			if err != nil {
+				return pfbResult{}, err
+			}
+			utx, err := user.NewUnsignedBlobTx(clientCtx.TxConfig, tx.GetTx(), blobs, f.ChainID(), f.AccountNumber(), f.Sequence())
+			if err != nil {
+				return pfbResult{}, err
+			}
+			return result, writeUnsignedBlobTx(cmd, utx)
+		}
+
+		txBytes, err := writeTx(clientCtx, f, pfbMsg)
+		if err != nil {
+			return pfbResult{}, err
+		}
+		if txBytes == nil {
+			if clientCtx.Simulate {
+				return result, nil
+			}
+			return pfbResult{}, errors.New("cancelled transaction")
+		}
+
+		blobTx, err := blob.MarshalBlobTx(txBytes, blobs...)
+		if err != nil {
+			return pfbResult{}, err
+		}
+
+		// broadcast to a Tendermint node
+		res, err := clientCtx.BroadcastTx(blobTx)
+		if err != nil {
+			return pfbResult{}, err
+		}
+		if err := clientCtx.PrintProto(res); err != nil {
+			return pfbResult{}, err
+		}
+		if res.Code != 0 {
+			return pfbResult{}, fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+		}
+
+		result.txHash = res.TxHash
+		result.height = res.Height
+		return result, nil
+	}
+
+	manifest, err := splitter.split(sources, submit)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if manifestPath != "" {
+		return os.WriteFile(manifestPath, bz, 0o600)
+	}
+	// the manifest is written to stderr to keep stdout for the tx responses
+	_, err = fmt.Fprintln(cmd.ErrOrStderr(), string(bz))
+	return err
+}
+
+// queryGovMaxSquareSize returns the governance max square size of the network.
+// It returns the default value when the transaction is built offline or only
+// generated, as no node is queried then.
+func queryGovMaxSquareSize(cmd *cobra.Command, clientCtx client.Context) (uint64, error) {
+	if clientCtx.Offline || clientCtx.GenerateOnly {
+		return appconsts.DefaultGovMaxSquareSize, nil
+	}
+	resp, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("querying blob params: %w", err)
+	}
+	return resp.Params.GovMaxSquareSize, nil
+}
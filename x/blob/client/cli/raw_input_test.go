@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/go-square/blob"
+	"github.com/celestiaorg/go-square/inclusion"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+func TestNewBlobSplitter(t *testing.T) {
+	ns := appns.RandomBlobNamespace()
+
+	type testCase struct {
+		name             string
+		govMaxSquareSize uint64
+		maxBlobSize      int
+		wantErr          bool
+	}
+	testCases := []testCase{
+		{name: "default gov max square size", govMaxSquareSize: appconsts.DefaultGovMaxSquareSize},
+		{name: "gov max square size above the upper bound", govMaxSquareSize: 512},
+		{name: "user provided max blob size", govMaxSquareSize: appconsts.DefaultGovMaxSquareSize, maxBlobSize: 1000},
+		{name: "negative max blob size", govMaxSquareSize: appconsts.DefaultGovMaxSquareSize, maxBlobSize: -1, wantErr: true},
+		{name: "max blob size that does not fit", govMaxSquareSize: 2, maxBlobSize: 4 * appconsts.ShareSize, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := newBlobSplitter(ns, appconsts.ShareVersionZero, tc.govMaxSquareSize, appconsts.LatestVersion, tc.maxBlobSize)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.LessOrEqual(t, s.squareSize, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+			assert.True(t, s.fits(s.maxBlobSize))
+			if tc.maxBlobSize == 0 {
+				// the next share worth of data must not fit anymore
+				assert.False(t, s.fits(s.maxBlobSize+appconsts.ContinuationSparseShareContentSize))
+				assert.LessOrEqual(t, shares.SparseSharesNeeded(uint32(s.maxBlobSize)), types.MaxBlobShares(s.squareSize))
+			}
+		})
+	}
+}
+
+func TestBlobSplitterSplit(t *testing.T) {
+	ns := appns.RandomBlobNamespace()
+	rand := tmrand.NewRand()
+
+	s, err := newBlobSplitter(ns, appconsts.ShareVersionZero, 8, appconsts.LatestVersion, 0)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"a.bin":     rand.Bytes(3*s.maxBlobSize + 10),
+		"b/c.bin":   rand.Bytes(100),
+		"empty.bin": {},
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+	}
+
+	sources, err := openRawSources(dir, nil)
+	require.NoError(t, err)
+	require.Len(t, sources, len(files))
+
+	var published []*blob.Blob
+	pfbs := 0
+	submit := func(blobs []*blob.Blob) (pfbResult, error) {
+		sizes := make([]int, len(blobs))
+		for i, b := range blobs {
+			sizes[i] = len(b.Data)
+		}
+		require.True(t, s.fits(sizes...))
+		commitments, err := inclusion.CreateCommitments(blobs, merkle.HashFromByteSlices, s.subtreeRootThreshold)
+		require.NoError(t, err)
+		published = append(published, blobs...)
+		pfbs++
+		return pfbResult{commitments: commitments}, nil
+	}
+
+	manifest, err := s.split(sources, submit)
+	require.NoError(t, err)
+	require.Len(t, manifest.Files, len(files))
+	require.Len(t, manifest.Blobs, len(published))
+	assert.Greater(t, pfbs, 1)
+	assert.Equal(t, hex.EncodeToString(ns.Bytes()), manifest.Namespace)
+
+	// reassemble every file from the published blobs using the manifest
+	reassembled := make([][]byte, len(manifest.Files))
+	for i, entry := range manifest.Blobs {
+		b := published[i]
+		require.Len(t, b.Data, entry.Size)
+		commitment, err := inclusion.CreateCommitment(b, merkle.HashFromByteSlices, s.subtreeRootThreshold)
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(commitment), entry.Commitment)
+		assert.EqualValues(t, len(reassembled[entry.File]), entry.Offset)
+		reassembled[entry.File] = append(reassembled[entry.File], b.Data...)
+	}
+	for i, file := range manifest.Files {
+		assert.True(t, bytes.Equal(files[file.Path], reassembled[i]), file.Path)
+		assert.EqualValues(t, len(files[file.Path]), file.Size)
+	}
+}
+
+func TestBlobSplitterSplitStdin(t *testing.T) {
+	ns := appns.RandomBlobNamespace()
+	data := tmrand.NewRand().Bytes(5000)
+
+	s, err := newBlobSplitter(ns, appconsts.ShareVersionZero, appconsts.DefaultGovMaxSquareSize, appconsts.LatestVersion, 1000)
+	require.NoError(t, err)
+
+	sources, err := openRawSources(StdinInput, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	var got []byte
+	manifest, err := s.split(sources, func(blobs []*blob.Blob) (pfbResult, error) {
+		commitments := make([][]byte, len(blobs))
+		for i, b := range blobs {
+			got = append(got, b.Data...)
+			commitments[i] = make([]byte, 32)
+		}
+		return pfbResult{commitments: commitments}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+	assert.Len(t, manifest.Blobs, 5)
+	assert.Equal(t, stdinSourceName, manifest.Files[0].Path)
+
+	_, err = s.split(sources[:0], nil)
+	assert.Error(t, err)
+
+	emptySources, err := openRawSources(StdinInput, io.LimitReader(nil, 0))
+	require.NoError(t, err)
+	_, err = s.split(emptySources, nil)
+	assert.Error(t, err)
+}
@@ -2,8 +2,10 @@ package testutil
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -22,6 +24,7 @@ import (
 	paycli "github.com/celestiaorg/celestia-app/v2/x/blob/client/cli"
 	appns "github.com/celestiaorg/go-square/namespace"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
 )
 
 // username is used to create a funded genesis account under this name
@@ -186,6 +189,107 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestSubmitRawPayForBlob() {
+	require := s.Require()
+
+	dir := s.T().TempDir()
+	data := tmrand.Bytes(3000)
+	rawFile := filepath.Join(dir, "batch.bin")
+	require.NoError(os.WriteFile(rawFile, data, 0o600))
+	manifestFile := filepath.Join(dir, "manifest.json")
+
+	require.NoError(s.ctx.WaitForNextBlock())
+	args := []string{
+		hex.EncodeToString(appns.RandomBlobNamespaceID()),
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", paycli.FlagRawInput, rawFile),
+		fmt.Sprintf("--%s=%d", paycli.FlagMaxBlobSize, 1000),
+		fmt.Sprintf("--%s=%s", paycli.FlagManifest, manifestFile),
+	}
+	out, err := clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdPayForBlob(), args)
+	require.NoError(err, out.String())
+
+	txResp := &sdk.TxResponse{}
+	require.NoError(s.ctx.Codec.UnmarshalJSON(out.Bytes(), txResp), out.String())
+	require.Equal(abci.CodeTypeOK, txResp.Code, out.String())
+
+	bz, err := os.ReadFile(manifestFile)
+	require.NoError(err)
+	var manifest struct {
+		Files []struct {
+			Path string
+			Size int64
+		}
+		Blobs []struct {
+			Offset     int64
+			Size       int
+			Commitment string
+			TxHash     string `json:"tx_hash"`
+		}
+	}
+	require.NoError(json.Unmarshal(bz, &manifest))
+	require.Len(manifest.Files, 1)
+	require.Equal("batch.bin", manifest.Files[0].Path)
+	require.EqualValues(len(data), manifest.Files[0].Size)
+	require.Len(manifest.Blobs, 3)
+	for i, b := range manifest.Blobs {
+		require.EqualValues(i*1000, b.Offset)
+		require.Equal(1000, b.Size)
+		require.Equal(txResp.TxHash, b.TxHash)
+		require.NotEmpty(b.Commitment)
+	}
+}
+
+func (s *IntegrationTestSuite) TestOfflineSignedPayForBlob() {
+	require := s.Require()
+	dir := s.T().TempDir()
+	unsignedFile := filepath.Join(dir, "unsigned.json")
+	signedFile := filepath.Join(dir, "signed.json")
+
+	require.NoError(s.ctx.WaitForNextBlock())
+	args := []string{
+		hex.EncodeToString(appns.RandomBlobNamespaceID()),
+		hex.EncodeToString(tmrand.Bytes(100)),
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+	out, err := clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdPayForBlob(), args)
+	require.NoError(err, out.String())
+	require.NoError(os.WriteFile(unsignedFile, out.Bytes(), 0o600))
+
+	// the blob tx can't be broadcast before it is signed
+	args = []string{
+		unsignedFile,
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	}
+	_, err = clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdBroadcastBlobTx(), args)
+	require.Error(err)
+
+	args = []string{
+		unsignedFile,
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=true", flags.FlagOffline),
+		fmt.Sprintf("--%s=%s", paycli.FlagOutputDocument, signedFile),
+	}
+	out, err = clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdSignBlobTx(), args)
+	require.NoError(err, out.String())
+
+	args = []string{
+		signedFile,
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	}
+	out, err = clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdBroadcastBlobTx(), args)
+	require.NoError(err, out.String())
+
+	txResp := &sdk.TxResponse{}
+	require.NoError(s.ctx.Codec.UnmarshalJSON(out.Bytes(), txResp), out.String())
+	require.Equal(abci.CodeTypeOK, txResp.Code, out.String())
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test in short mode.")
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/celestiaorg/go-square/inclusion"
+	appshares "github.com/celestiaorg/go-square/shares"
+)
+
+const (
+	// pfbTxBaseSize and pfbTxBytesPerBlob are used to conservatively estimate
+	// the size of a signed PFB transaction so that the number of compact
+	// shares it occupies can be reserved when checking if blobs fit in a
+	// square.
+	pfbTxBaseSize     = 1024
+	pfbTxBytesPerBlob = 128
+)
+
+// MaxBlobShares returns the max number of shares available for the blobs of a
+// single MsgPayForBlobs in a data square of the provided size. It is the limit
+// enforced by the BlobShareDecorator.
+func MaxBlobShares(squareSize int) int {
+	// The PFB tx share must occupy at least one share so the number of blob
+	// shares is at most one less than the total number of shares.
+	return squareSize*squareSize - 1
+}
+
+// BlobsFitInSquare returns true if a MsgPayForBlobs paying for blobs of the
+// provided sizes can be included in an otherwise empty data square of the
+// provided size. Besides the MaxBlobShares limit, it accounts for the shares
+// occupied by the PFB transaction and for the worst case padding introduced by
+// the blob share commitment rules.
+func BlobsFitInSquare(squareSize, subtreeRootThreshold int, blobSizes ...int) bool {
+	total := appshares.CompactSharesNeeded(pfbTxBaseSize + pfbTxBytesPerBlob*len(blobSizes))
+	blobShares := 0
+	for _, size := range blobSizes {
+		sharesNeeded := appshares.SparseSharesNeeded(uint32(size))
+		blobShares += sharesNeeded
+		total += sharesNeeded + inclusion.SubTreeWidth(sharesNeeded, subtreeRootThreshold) - 1
+	}
+	return blobShares <= MaxBlobShares(squareSize) && total <= squareSize*squareSize
+}
+
+// LargestBlobSize returns the size in bytes of the largest blob that fits in
+// an otherwise empty data square of the provided size alongside its
+// MsgPayForBlobs. It returns zero if no blob fits.
+func LargestBlobSize(squareSize, subtreeRootThreshold int) int {
+	for sharesNeeded := MaxBlobShares(squareSize); sharesNeeded > 0; sharesNeeded-- {
+		size := appshares.AvailableBytesFromSparseShares(sharesNeeded)
+		if BlobsFitInSquare(squareSize, subtreeRootThreshold, size) {
+			return size
+		}
+	}
+	return 0
+}
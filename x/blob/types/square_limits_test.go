@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/go-square/shares"
+)
+
+func TestLargestBlobSize(t *testing.T) {
+	threshold := appconsts.DefaultSubtreeRootThreshold
+	for _, squareSize := range []int{2, 4, 8, 16, 32, 64, 128} {
+		size := types.LargestBlobSize(squareSize, threshold)
+		assert.Greater(t, size, 0)
+		assert.True(t, types.BlobsFitInSquare(squareSize, threshold, size))
+		assert.LessOrEqual(t, shares.SparseSharesNeeded(uint32(size)), types.MaxBlobShares(squareSize))
+		// one more share worth of data must not fit anymore
+		assert.False(t, types.BlobsFitInSquare(squareSize, threshold, size+appconsts.ContinuationSparseShareContentSize))
+	}
+	assert.Equal(t, 0, types.LargestBlobSize(1, threshold))
+}
+
+func TestBlobsFitInSquare(t *testing.T) {
+	threshold := appconsts.DefaultSubtreeRootThreshold
+	largest := types.LargestBlobSize(64, threshold)
+
+	assert.True(t, types.BlobsFitInSquare(64, threshold))
+	assert.True(t, types.BlobsFitInSquare(64, threshold, 1, 1000, 10000))
+	assert.True(t, types.BlobsFitInSquare(64, threshold, largest/2, largest/4))
+	assert.False(t, types.BlobsFitInSquare(64, threshold, largest, 1))
+	assert.False(t, types.BlobsFitInSquare(64, threshold, largest*2))
+}
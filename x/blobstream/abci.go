@@ -2,7 +2,6 @@ package blobstream
 
 import (
 	"errors"
-	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 
@@ -11,22 +10,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-const (
-	oneDay  = 24 * time.Hour
-	oneWeek = 7 * oneDay
-	// AttestationExpiryTime is the expiration time of an attestation. When this
-	// much time has passed after an attestation has been published, it will be
-	// pruned from state.
-	AttestationExpiryTime = 3 * oneWeek // 3 weeks
-)
-
-// SignificantPowerDifferenceThreshold is the threshold of change in the
-// validator set power that would trigger the creation of a new valset
-// request.
-var SignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(5, 2) // 0.05
-
 // EndBlocker is called at the end of every block.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.DiscardUncommittedAttestations()
 	// we always want to create the valset at first so that if there is a new
 	// validator set, then it is the one responsible for signing from now on.
 	handleValsetRequest(ctx, k)
@@ -117,7 +103,7 @@ func handleValsetRequest(ctx sdk.Context, k keeper.Keeper) {
 			panic(sdkerrors.Wrap(err, "invalid latest valset members"))
 		}
 
-		significantPowerDiff = intCurrMembers.PowerDiff(*intLatestMembers).GT(SignificantPowerDifferenceThreshold)
+		significantPowerDiff = intCurrMembers.PowerDiff(*intLatestMembers).GT(k.GetSignificantPowerDifferenceThresholdParam(ctx))
 
 	}
 
@@ -149,6 +135,7 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	currentBlockTime := ctx.BlockTime()
+	attestationExpiryTime := k.GetAttestationExpiryTimeParam(ctx)
 	latestAttestationNonce := k.GetLatestAttestationNonce(ctx)
 	earliestNonce := k.GetEarliestAvailableAttestationNonce(ctx)
 	var newEarliestAvailableNonce uint64
@@ -166,7 +153,7 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 			ctx.Logger().Error("nil attestation for pruning", "nonce", newEarliestAvailableNonce)
 			return
 		}
-		attestationExpirationTime := newEarliestAttestation.BlockTime().Add(AttestationExpiryTime)
+		attestationExpirationTime := newEarliestAttestation.BlockTime().Add(attestationExpiryTime)
 		if attestationExpirationTime.After(currentBlockTime) {
 			// the current attestation is unexpired so subsequent ones are also
 			// unexpired persist the new earliest available attestation nonce
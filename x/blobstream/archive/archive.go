@@ -0,0 +1,142 @@
+// Package archive exports the Blobstream state of a v1 application database to
+// a portable file, and answers the Blobstream queries from that file without a
+// node.
+//
+// x/blobstream was disabled in app version 2 and its store deleted, so the
+// historical attestations are only available in the databases of the nodes
+// that kept the v1 heights.
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// FormatVersion is the version of the archive file format. It is increased
+// when the format changes in a way that can't be read by older versions.
+const FormatVersion = 1
+
+// Archive is the Blobstream state at a given height.
+type Archive struct {
+	FormatVersion uint64 `json:"format_version"`
+	// ChainID is the chain ID of the exported state. It is informational and
+	// can be empty.
+	ChainID string `json:"chain_id,omitempty"`
+	// Height is the height of the exported state.
+	Height int64        `json:"height"`
+	Params types.Params `json:"params"`
+	// LatestAttestationNonce is the nonce of the last attestation created at
+	// Height. Zero if no attestation was created.
+	LatestAttestationNonce uint64 `json:"latest_attestation_nonce"`
+	// EarliestAvailableAttestationNonce is the nonce of the oldest attestation
+	// that was not pruned at Height.
+	EarliestAvailableAttestationNonce uint64 `json:"earliest_available_attestation_nonce"`
+	LatestUnbondingHeight             uint64 `json:"latest_unbonding_height"`
+	// Valsets and DataCommitments are ordered by ascending nonce. Together,
+	// they contain all the nonces from EarliestAvailableAttestationNonce to
+	// LatestAttestationNonce.
+	Valsets         []types.Valset         `json:"valsets"`
+	DataCommitments []types.DataCommitment `json:"data_commitments"`
+	EVMAddresses    []EVMAddress           `json:"evm_addresses"`
+}
+
+// EVMAddress is the EVM address registered by a validator.
+type EVMAddress struct {
+	// ValidatorAddress is the bech32 encoded validator operator address.
+	ValidatorAddress string `json:"validator_address"`
+	// EVMAddress is the hex encoded EVM address.
+	EVMAddress string `json:"evm_address"`
+}
+
+// Validate checks that the archive is consistent: all the attestations between
+// the earliest available and the latest nonces are present exactly once, and
+// the params and EVM addresses are valid.
+func (a Archive) Validate() error {
+	if a.FormatVersion != FormatVersion {
+		return fmt.Errorf("unsupported archive format version %d, expected %d", a.FormatVersion, FormatVersion)
+	}
+	if a.Height <= 0 {
+		return fmt.Errorf("invalid archive height %d", a.Height)
+	}
+	if err := a.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	nonces := make(map[uint64]struct{}, len(a.Valsets)+len(a.DataCommitments))
+	for _, at := range a.attestations() {
+		nonce := at.GetNonce()
+		if nonce < a.EarliestAvailableAttestationNonce || nonce > a.LatestAttestationNonce {
+			return fmt.Errorf(
+				"attestation nonce %d outside of the available nonces [%d, %d]",
+				nonce,
+				a.EarliestAvailableAttestationNonce,
+				a.LatestAttestationNonce,
+			)
+		}
+		if _, ok := nonces[nonce]; ok {
+			return fmt.Errorf("duplicate attestation nonce %d", nonce)
+		}
+		nonces[nonce] = struct{}{}
+	}
+	if a.LatestAttestationNonce != 0 {
+		if a.EarliestAvailableAttestationNonce == 0 || a.EarliestAvailableAttestationNonce > a.LatestAttestationNonce {
+			return fmt.Errorf("invalid earliest available attestation nonce %d", a.EarliestAvailableAttestationNonce)
+		}
+		if expected := a.LatestAttestationNonce - a.EarliestAvailableAttestationNonce + 1; uint64(len(nonces)) != expected {
+			return fmt.Errorf("expected %d attestations, got %d", expected, len(nonces))
+		}
+	}
+
+	for _, evmAddress := range a.EVMAddresses {
+		if _, err := sdk.ValAddressFromBech32(evmAddress.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address %s: %w", evmAddress.ValidatorAddress, err)
+		}
+		if !gethcommon.IsHexAddress(evmAddress.EVMAddress) {
+			return fmt.Errorf("invalid EVM address %s of validator %s", evmAddress.EVMAddress, evmAddress.ValidatorAddress)
+		}
+	}
+	return nil
+}
+
+// attestations returns the valsets and data commitments of the archive as
+// attestations.
+func (a Archive) attestations() []types.AttestationRequestI {
+	attestations := make([]types.AttestationRequestI, 0, len(a.Valsets)+len(a.DataCommitments))
+	for i := range a.Valsets {
+		attestations = append(attestations, &a.Valsets[i])
+	}
+	for i := range a.DataCommitments {
+		attestations = append(attestations, &a.DataCommitments[i])
+	}
+	return attestations
+}
+
+// WriteFile writes the archive as JSON to the provided path.
+func WriteFile(path string, a *Archive) error {
+	bz, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(bz, '\n'), 0o600)
+}
+
+// ReadFile reads and validates an archive written by WriteFile.
+func ReadFile(path string) (*Archive, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var a Archive
+	if err := json.Unmarshal(bz, &a); err != nil {
+		return nil, fmt.Errorf("decoding archive from %s: %w", path, err)
+	}
+	if err := a.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid archive %s: %w", path, err)
+	}
+	return &a, nil
+}
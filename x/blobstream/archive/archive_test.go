@@ -0,0 +1,164 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+)
+
+// setupV1State commits a v1 Blobstream state at heights 1 and 2 to an
+// application database: a valset and three data commitments at height 1, then
+// a fifth attestation at height 2.
+func setupV1State(t *testing.T) (dbm.DB, storetypes.CommitMultiStore, stores) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	s := mountStores(ms)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+
+	// v1 only had the data commitment window param
+	s.subspace.Set(ctx, types.ParamsStoreKeyDataCommitmentWindow, uint64(100))
+	valAddress := sdk.ValAddress(bytes.Repeat([]byte{1}, 20))
+	evmAddress := gethcommon.HexToAddress("0x966e6f22781EF6a6A82BBB4DB3df8E225DfD9488")
+	s.keeper.SetEVMAddress(ctx, valAddress, evmAddress)
+
+	member, err := types.NewInternalBridgeValidator(types.BridgeValidator{Power: 100, EvmAddress: evmAddress.Hex()})
+	require.NoError(t, err)
+	vs, err := types.NewValset(1, 1, types.InternalBridgeValidators{member}, time.Unix(1, 0).UTC())
+	require.NoError(t, err)
+	require.NoError(t, s.keeper.SetAttestationRequest(ctx, vs))
+	for i := uint64(0); i < 3; i++ {
+		dc := types.NewDataCommitment(i+2, 100*i+1, 100*(i+1)+1, time.Unix(2, 0).UTC())
+		require.NoError(t, s.keeper.SetAttestationRequest(ctx, dc))
+	}
+	s.keeper.SetEarliestAvailableAttestationNonce(ctx, 1)
+	ms.Commit()
+
+	require.NoError(t, s.keeper.SetAttestationRequest(ctx, types.NewDataCommitment(5, 301, 401, time.Unix(3, 0).UTC())))
+	ms.Commit()
+	return db, ms, s
+}
+
+func TestExportFromDB(t *testing.T) {
+	db, _, _ := setupV1State(t)
+
+	a, err := ExportFromDB(db, 1)
+	require.NoError(t, err)
+	require.NoError(t, a.Validate())
+	assert.Equal(t, int64(1), a.Height)
+	assert.Equal(t, uint64(4), a.LatestAttestationNonce)
+	assert.Equal(t, uint64(1), a.EarliestAvailableAttestationNonce)
+	assert.Len(t, a.Valsets, 1)
+	assert.Len(t, a.DataCommitments, 3)
+	require.Len(t, a.EVMAddresses, 1)
+	assert.Equal(t, "0x966e6f22781EF6a6A82BBB4DB3df8E225DfD9488", a.EVMAddresses[0].EVMAddress)
+	assert.Equal(t, uint64(100), a.Params.DataCommitmentWindow)
+	// the params that didn't exist in v1 are exported with their defaults
+	assert.Equal(t, types.DefaultAttestationExpiryTime, a.Params.AttestationExpiryTime)
+
+	a, err = ExportFromDB(db, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), a.LatestAttestationNonce)
+	assert.Len(t, a.DataCommitments, 4)
+
+	_, err = ExportFromDB(db, 3)
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	db, _, _ := setupV1State(t)
+	a, err := ExportFromDB(db, 2)
+	require.NoError(t, err)
+
+	missing := *a
+	missing.DataCommitments = a.DataCommitments[1:]
+	assert.Error(t, missing.Validate())
+
+	duplicate := *a
+	duplicate.DataCommitments = append(duplicate.DataCommitments, a.DataCommitments[0])
+	assert.Error(t, duplicate.Validate())
+
+	invalidEVMAddress := *a
+	invalidEVMAddress.EVMAddresses = []EVMAddress{{ValidatorAddress: a.EVMAddresses[0].ValidatorAddress, EVMAddress: "invalid"}}
+	assert.Error(t, invalidEVMAddress.Validate())
+}
+
+// mockAttestationsStream implements types.Query_AttestationsStreamServer and
+// records the sent attestation nonces.
+type mockAttestationsStream struct {
+	grpc.ServerStream
+	nonces []uint64
+}
+
+func (s *mockAttestationsStream) Context() context.Context { return context.Background() }
+
+func (s *mockAttestationsStream) Send(resp *types.QueryAttestationsStreamResponse) error {
+	s.nonces = append(s.nonces, resp.Attestation.GetCachedValue().(types.AttestationRequestI).GetNonce())
+	return nil
+}
+
+func TestQueryServer(t *testing.T) {
+	db, ms, s := setupV1State(t)
+	a, err := ExportFromDB(db, 2)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "archive.json")
+	require.NoError(t, WriteFile(path, a))
+	a, err = ReadFile(path)
+	require.NoError(t, err)
+	qs, err := NewQueryServer(a)
+	require.NoError(t, err)
+
+	// the queries answered offline match the ones of the node
+	goCtx := sdk.WrapSDKContext(sdk.NewContext(ms.CacheMultiStore(), tmproto.Header{Height: 2}, false, log.NewNopLogger()))
+	offlineCtx := context.Background()
+
+	rangeReq := &types.QueryAttestationsByNonceRangeRequest{BeginNonce: 2, Pagination: &query.PageRequest{Limit: 2}}
+	expectedRange, err := s.keeper.AttestationsByNonceRange(goCtx, rangeReq)
+	require.NoError(t, err)
+	gotRange, err := qs.AttestationsByNonceRange(offlineCtx, rangeReq)
+	require.NoError(t, err)
+	assert.Equal(t, expectedRange, gotRange)
+
+	dcReq := &types.QueryDataCommitmentRangeForHeightRequest{Height: 250}
+	expectedDC, err := s.keeper.DataCommitmentRangeForHeight(goCtx, dcReq)
+	require.NoError(t, err)
+	gotDC, err := qs.DataCommitmentRangeForHeight(offlineCtx, dcReq)
+	require.NoError(t, err)
+	assert.Equal(t, expectedDC, gotDC)
+
+	vsReq := &types.QueryLatestValsetRequestBeforeNonceRequest{Nonce: 5}
+	expectedVS, err := s.keeper.LatestValsetRequestBeforeNonce(goCtx, vsReq)
+	require.NoError(t, err)
+	gotVS, err := qs.LatestValsetRequestBeforeNonce(offlineCtx, vsReq)
+	require.NoError(t, err)
+	assert.Equal(t, expectedVS, gotVS)
+
+	evmReq := &types.QueryEVMAddressRequest{ValidatorAddress: a.EVMAddresses[0].ValidatorAddress}
+	gotEVM, err := qs.EVMAddress(offlineCtx, evmReq)
+	require.NoError(t, err)
+	assert.Equal(t, a.EVMAddresses[0].EVMAddress, gotEVM.EvmAddress)
+
+	params, err := qs.Params(offlineCtx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, a.Params, params.Params)
+
+	stream := &mockAttestationsStream{}
+	require.NoError(t, qs.AttestationsStream(&types.QueryAttestationsStreamRequest{StartNonce: 3}, stream))
+	assert.Equal(t, []uint64{3, 4, 5}, stream.nonces)
+}
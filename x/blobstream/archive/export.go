@@ -0,0 +1,120 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/keeper"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// stores are the stores holding the Blobstream state, mounted on a multistore.
+type stores struct {
+	keeper   keeper.Keeper
+	subspace paramstypes.Subspace
+}
+
+// mountStores mounts the Blobstream and params stores on the multistore and
+// returns a keeper and a params subspace reading from them. The stores are
+// mounted on the multistore database, as the application does, and the
+// multistore must be loaded afterwards.
+func mountStores(ms storetypes.CommitMultiStore) stores {
+	bsKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	ms.MountStoreWithDB(bsKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tParamsKey, storetypes.StoreTypeTransient, nil)
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	subspace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsKey, tParamsKey, types.DefaultParamspace).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the staking keeper is only used to create new valsets, which never
+	// happens when reading or serving an archive.
+	return stores{
+		keeper:   *keeper.NewKeeper(cdc, bsKey, subspace, nil),
+		subspace: subspace,
+	}
+}
+
+// ExportFromDB exports the Blobstream state at the provided height of an
+// application database. The height must be an app version 1 height that was
+// not pruned. The database is only read.
+func ExportFromDB(db dbm.DB, height int64) (*Archive, error) {
+	ms := store.NewCommitMultiStore(db)
+	ms.SetLazyLoading(true)
+	s := mountStores(ms)
+	if err := ms.LoadVersion(height); err != nil {
+		return nil, fmt.Errorf("loading the Blobstream state at height %d, the height must be a v1 height that was not pruned: %w", height, err)
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: height}, false, log.NewNopLogger())
+	return Export(ctx, s.keeper, s.subspace)
+}
+
+// Export exports the Blobstream state of the provided context. The params
+// that were not set in that state are exported with their default values.
+func Export(ctx sdk.Context, k keeper.Keeper, subspace paramstypes.Subspace) (*Archive, error) {
+	a := &Archive{
+		FormatVersion:         FormatVersion,
+		ChainID:               ctx.ChainID(),
+		Height:                ctx.BlockHeight(),
+		Params:                types.DefaultParams(),
+		LatestUnbondingHeight: k.GetLatestUnBondingBlockHeight(ctx),
+		Valsets:               []types.Valset{},
+		DataCommitments:       []types.DataCommitment{},
+		EVMAddresses:          []EVMAddress{},
+	}
+	// the attestation expiry time and significant power difference threshold
+	// were constants before being made params.
+	subspace.GetIfExists(ctx, types.ParamsStoreKeyDataCommitmentWindow, &a.Params.DataCommitmentWindow)
+	subspace.GetIfExists(ctx, types.ParamsStoreKeyAttestationExpiryTime, &a.Params.AttestationExpiryTime)
+	subspace.GetIfExists(ctx, types.ParamsStoreKeySignificantPowerDifferenceThreshold, &a.Params.SignificantPowerDifferenceThreshold)
+
+	if k.CheckLatestAttestationNonce(ctx) {
+		a.LatestAttestationNonce = k.GetLatestAttestationNonce(ctx)
+	}
+	if k.CheckEarliestAvailableAttestationNonce(ctx) {
+		a.EarliestAvailableAttestationNonce = k.GetEarliestAvailableAttestationNonce(ctx)
+	}
+
+	var unknownErr error
+	err := k.IterateAttestations(ctx, func(at types.AttestationRequestI) bool {
+		switch at := at.(type) {
+		case *types.Valset:
+			a.Valsets = append(a.Valsets, *at)
+		case *types.DataCommitment:
+			a.DataCommitments = append(a.DataCommitments, *at)
+		default:
+			unknownErr = fmt.Errorf("unknown attestation type %T at nonce %d", at, at.GetNonce())
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if unknownErr != nil {
+		return nil, unknownErr
+	}
+
+	k.IterateEVMAddresses(ctx, func(valAddress sdk.ValAddress, evmAddress gethcommon.Address) bool {
+		a.EVMAddresses = append(a.EVMAddresses, EVMAddress{
+			ValidatorAddress: valAddress.String(),
+			EVMAddress:       evmAddress.Hex(),
+		})
+		return false
+	})
+	return a, nil
+}
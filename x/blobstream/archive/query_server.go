@@ -0,0 +1,140 @@
+package archive
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var _ types.QueryServer = &QueryServer{}
+
+// QueryServer answers the Blobstream queries from an archive, as a node would
+// have answered them at the archive height. The archive is loaded into an in
+// memory store and the queries are served by the module keeper, so that the
+// answers, pagination included, are the same as the ones of a node.
+type QueryServer struct {
+	stores
+	ms  storetypes.CommitMultiStore
+	ctx sdk.Context
+}
+
+// NewQueryServer loads the archive into memory and returns a query server
+// answering from it.
+func NewQueryServer(a *Archive) (*QueryServer, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	s := mountStores(ms)
+	if err := ms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.NewContext(ms, tmproto.Header{ChainID: a.ChainID, Height: a.Height}, false, log.NewNopLogger())
+
+	s.keeper.SetParams(ctx, a.Params)
+	for _, at := range a.attestations() {
+		s.keeper.StoreAttestation(ctx, at)
+	}
+	if a.LatestAttestationNonce != 0 {
+		s.keeper.SetLatestAttestationNonce(ctx, a.LatestAttestationNonce)
+		s.keeper.SetEarliestAvailableAttestationNonce(ctx, a.EarliestAvailableAttestationNonce)
+	}
+	s.keeper.SetLatestUnBondingBlockHeight(ctx, a.LatestUnbondingHeight)
+	for _, evmAddress := range a.EVMAddresses {
+		valAddress, err := sdk.ValAddressFromBech32(evmAddress.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		s.keeper.SetEVMAddress(ctx, valAddress, gethcommon.HexToAddress(evmAddress.EVMAddress))
+	}
+	ms.Commit()
+
+	return &QueryServer{stores: s, ms: ms, ctx: ctx}, nil
+}
+
+// context returns a context reading from a cache of the archive state, so that
+// the queries can be served concurrently.
+func (s *QueryServer) context(c context.Context) context.Context {
+	return sdk.WrapSDKContext(s.ctx.WithContext(c).WithMultiStore(s.ms.CacheMultiStore()))
+}
+
+func (s *QueryServer) Params(c context.Context, request *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return s.keeper.Params(s.context(c), request)
+}
+
+func (s *QueryServer) AttestationRequestByNonce(c context.Context, request *types.QueryAttestationRequestByNonceRequest) (*types.QueryAttestationRequestByNonceResponse, error) {
+	return s.keeper.AttestationRequestByNonce(s.context(c), request)
+}
+
+func (s *QueryServer) LatestAttestationNonce(c context.Context, request *types.QueryLatestAttestationNonceRequest) (*types.QueryLatestAttestationNonceResponse, error) {
+	return s.keeper.LatestAttestationNonce(s.context(c), request)
+}
+
+func (s *QueryServer) EarliestAttestationNonce(c context.Context, request *types.QueryEarliestAttestationNonceRequest) (*types.QueryEarliestAttestationNonceResponse, error) {
+	return s.keeper.EarliestAttestationNonce(s.context(c), request)
+}
+
+func (s *QueryServer) AttestationsByNonceRange(c context.Context, request *types.QueryAttestationsByNonceRangeRequest) (*types.QueryAttestationsByNonceRangeResponse, error) {
+	return s.keeper.AttestationsByNonceRange(s.context(c), request)
+}
+
+func (s *QueryServer) AttestationsByHeightRange(c context.Context, request *types.QueryAttestationsByHeightRangeRequest) (*types.QueryAttestationsByHeightRangeResponse, error) {
+	return s.keeper.AttestationsByHeightRange(s.context(c), request)
+}
+
+// AttestationsStream sends the archived attestations starting from the
+// requested nonce, then ends the stream since no attestation is created after
+// the archive height.
+func (s *QueryServer) AttestationsStream(request *types.QueryAttestationsStreamRequest, stream types.Query_AttestationsStreamServer) error {
+	ctx := sdk.UnwrapSDKContext(s.context(stream.Context()))
+	var sendErr error
+	err := s.keeper.IterateAttestations(ctx, func(at types.AttestationRequestI) bool {
+		if at.GetNonce() < request.StartNonce {
+			return false
+		}
+		val, err := codectypes.NewAnyWithValue(at)
+		if err != nil {
+			sendErr = err
+			return true
+		}
+		sendErr = stream.Send(&types.QueryAttestationsStreamResponse{Attestation: val})
+		return sendErr != nil
+	})
+	if err != nil {
+		return err
+	}
+	return sendErr
+}
+
+func (s *QueryServer) LatestValsetRequestBeforeNonce(c context.Context, request *types.QueryLatestValsetRequestBeforeNonceRequest) (*types.QueryLatestValsetRequestBeforeNonceResponse, error) {
+	return s.keeper.LatestValsetRequestBeforeNonce(s.context(c), request)
+}
+
+func (s *QueryServer) LatestUnbondingHeight(c context.Context, request *types.QueryLatestUnbondingHeightRequest) (*types.QueryLatestUnbondingHeightResponse, error) {
+	return s.keeper.LatestUnbondingHeight(s.context(c), request)
+}
+
+func (s *QueryServer) DataCommitmentRangeForHeight(c context.Context, request *types.QueryDataCommitmentRangeForHeightRequest) (*types.QueryDataCommitmentRangeForHeightResponse, error) {
+	return s.keeper.DataCommitmentRangeForHeight(s.context(c), request)
+}
+
+func (s *QueryServer) DataCommitmentsForHeightRange(c context.Context, request *types.QueryDataCommitmentsForHeightRangeRequest) (*types.QueryDataCommitmentsForHeightRangeResponse, error) {
+	return s.keeper.DataCommitmentsForHeightRange(s.context(c), request)
+}
+
+func (s *QueryServer) LatestDataCommitment(c context.Context, request *types.QueryLatestDataCommitmentRequest) (*types.QueryLatestDataCommitmentResponse, error) {
+	return s.keeper.LatestDataCommitment(s.context(c), request)
+}
+
+func (s *QueryServer) EVMAddress(c context.Context, request *types.QueryEVMAddressRequest) (*types.QueryEVMAddressResponse, error) {
+	return s.keeper.EVMAddress(s.context(c), request)
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/archive"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func ExportArchiveCmd() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "export-archive <height> <output_file>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Exports the Blobstream state of the application database at a v1 height to a portable file",
+		Long: `Exports the Blobstream state of the application database at a v1 height to a portable file.
+
+The file contains every valset, data commitment and EVM address mapping available at that height, and can be loaded with the x/blobstream/archive package to answer the Blobstream queries offline.
+The height must not have been pruned, and the node must be stopped since the application database is opened directly.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			genesis, err := tmtypes.GenesisDocFromFile(serverCtx.Config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(serverCtx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			a, err := archive.ExportFromDB(db, height)
+			if err != nil {
+				return err
+			}
+			a.ChainID = genesis.ChainID
+			if err := archive.WriteFile(args[1], a); err != nil {
+				return err
+			}
+			fmt.Printf(
+				"exported %d valsets, %d data commitments and %d EVM addresses at height %d to %s\n",
+				len(a.Valsets),
+				len(a.DataCommitments),
+				len(a.EVMAddresses),
+				height,
+				args[1],
+			)
+			return nil
+		},
+	}
+	return command
+}
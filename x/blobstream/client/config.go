@@ -14,6 +14,18 @@ const (
 	celesGRPCFlag       = "celes-grpc"
 	evmRPCFlag          = "evm-rpc"
 	contractAddressFlag = "contract-address"
+	localFlag           = "local"
+	attestationsFlag    = "attestations-file"
+	formatFlag          = "format"
+	outputFileFlag      = "output-file"
+)
+
+const (
+	// FormatJSON outputs the proof bundle encoded as JSON.
+	FormatJSON = "json"
+	// FormatCalldata outputs the hex encoded verifyAttestation calldata of the
+	// proof bundle.
+	FormatCalldata = "calldata"
 )
 
 func addVerifyFlags(cmd *cobra.Command) *cobra.Command {
@@ -23,6 +35,8 @@ func addVerifyFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().StringP(evmRPCFlag, "e", "http://localhost:8545", "The EVM RPC address")
 	cmd.Flags().StringP(contractAddressFlag, "a", "", "The contract address at which Blobstream is deployed")
 	cmd.Flags().StringP(celesGRPCFlag, "c", "localhost:9090", "<host>:<port> To Celestia GRPC address")
+	cmd.Flags().Bool(localFlag, false, "Verify against a data root tuple root computed from the chain headers instead of the Blobstream contract")
+	cmd.Flags().String(attestationsFlag, "", "Verify against the attested commitments in this JSON file instead of the Blobstream contract")
 
 	return cmd
 }
@@ -32,6 +46,12 @@ type VerifyConfig struct {
 	EVMRPC, CelesGRPC, TendermintRPC string
 	EVMChainID                       uint64
 	ContractAddr                     ethcmn.Address
+	// Local verifies against a data root tuple root computed from the chain
+	// instead of the contract.
+	Local bool
+	// AttestationsFile verifies against the attested commitments of the file
+	// instead of the contract.
+	AttestationsFile string
 }
 
 func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
@@ -55,24 +75,82 @@ func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
 	if err != nil {
 		return VerifyConfig{}, err
 	}
+	local, err := cmd.Flags().GetBool(localFlag)
+	if err != nil {
+		return VerifyConfig{}, err
+	}
+	attestationsFile, err := cmd.Flags().GetString(attestationsFlag)
+	if err != nil {
+		return VerifyConfig{}, err
+	}
+	if local && attestationsFile != "" {
+		return VerifyConfig{}, fmt.Errorf("flags %s and %s are mutually exclusive", localFlag, attestationsFlag)
+	}
 	contractAddr, err := cmd.Flags().GetString(contractAddressFlag)
 	if err != nil {
 		return VerifyConfig{}, err
 	}
-	if contractAddr == "" {
+	offline := local || attestationsFile != ""
+	if contractAddr == "" && !offline {
 		return VerifyConfig{}, fmt.Errorf("contract address flag is required: %s", contractAddressFlag)
 	}
-	if !ethcmn.IsHexAddress(contractAddr) {
+	if contractAddr != "" && !ethcmn.IsHexAddress(contractAddr) {
 		return VerifyConfig{}, fmt.Errorf("valid contract address flag is required: %s", contractAddressFlag)
 	}
 	address := ethcmn.HexToAddress(contractAddr)
 
 	return VerifyConfig{
-		CelestiaChainID: chainID,
-		EVMChainID:      evmChainID,
-		CelesGRPC:       celesGRPC,
-		TendermintRPC:   tendermintRPC,
-		EVMRPC:          evmRPC,
-		ContractAddr:    address,
+		CelestiaChainID:  chainID,
+		EVMChainID:       evmChainID,
+		CelesGRPC:        celesGRPC,
+		TendermintRPC:    tendermintRPC,
+		EVMRPC:           evmRPC,
+		ContractAddr:     address,
+		Local:            local,
+		AttestationsFile: attestationsFile,
+	}, nil
+}
+
+func addExportFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringP(flags.FlagNode, "t", "http://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().StringP(celesGRPCFlag, "c", "localhost:9090", "<host>:<port> To Celestia GRPC address")
+	cmd.Flags().StringP(formatFlag, "f", FormatJSON, fmt.Sprintf("The output format of the proof bundle: %s or %s", FormatJSON, FormatCalldata))
+	cmd.Flags().StringP(outputFileFlag, "o", "", "Write the proof bundle to this file instead of stdout")
+
+	return cmd
+}
+
+type ExportConfig struct {
+	CelesGRPC, TendermintRPC string
+	Format                   string
+	OutputFile               string
+}
+
+func parseExportFlags(cmd *cobra.Command) (ExportConfig, error) {
+	tendermintRPC, err := cmd.Flags().GetString(flags.FlagNode)
+	if err != nil {
+		return ExportConfig{}, err
+	}
+	celesGRPC, err := cmd.Flags().GetString(celesGRPCFlag)
+	if err != nil {
+		return ExportConfig{}, err
+	}
+	format, err := cmd.Flags().GetString(formatFlag)
+	if err != nil {
+		return ExportConfig{}, err
+	}
+	if format != FormatJSON && format != FormatCalldata {
+		return ExportConfig{}, fmt.Errorf("invalid format %q, expected %s or %s", format, FormatJSON, FormatCalldata)
+	}
+	outputFile, err := cmd.Flags().GetString(outputFileFlag)
+	if err != nil {
+		return ExportConfig{}, err
+	}
+
+	return ExportConfig{
+		CelesGRPC:     celesGRPC,
+		TendermintRPC: tendermintRPC,
+		Format:        format,
+		OutputFile:    outputFile,
 	}, nil
 }
@@ -0,0 +1,234 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/spf13/cobra"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// shareRangeResolver returns the height and the end exclusive range of shares
+// to export the proof bundle of from the arguments of a command.
+type shareRangeResolver func(ctx context.Context, trpc rpcclient.SignClient, args []string) (height int64, startShare uint64, endShare uint64, err error)
+
+func ExportProofCmd() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "export-proof",
+		Short: "Exports a self-contained proof bundle that a transaction, a range of shares, or a blob referenced by its transaction hash were committed to by Blobstream",
+		Long: `Exports a self-contained proof bundle that a transaction, a range of shares, or a blob referenced by its transaction hash were committed to by Blobstream.
+
+The bundle contains the share proof, the proofs of the rows to the data root, the proof of the data root tuple to the data commitment, and the nonce and block range of the data commitment.
+It is output either as JSON, or as the ABI encoded calldata of the verifyAttestation method of the Blobstream contract, so that it can be submitted without querying Celestia.`,
+	}
+	command.AddCommand(
+		exportProofSubCmd(
+			"tx <tx_hash>",
+			"Exports the proof bundle of a transaction hash, in hex format",
+			1,
+			func(ctx context.Context, trpc rpcclient.SignClient, args []string) (int64, uint64, uint64, error) {
+				txHash, err := hex.DecodeString(args[0])
+				if err != nil {
+					return 0, 0, 0, err
+				}
+				return txShareRange(ctx, trpc, txHash)
+			},
+		),
+		exportProofSubCmd(
+			"shares <height> <start_share> <end_share>",
+			"Exports the proof bundle of a range of shares. The range should be end exclusive.",
+			3,
+			func(_ context.Context, _ rpcclient.SignClient, args []string) (int64, uint64, uint64, error) {
+				height, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return 0, 0, 0, err
+				}
+				startShare, err := strconv.ParseUint(args[1], 10, 0)
+				if err != nil {
+					return 0, 0, 0, err
+				}
+				endShare, err := strconv.ParseUint(args[2], 10, 0)
+				if err != nil {
+					return 0, 0, 0, err
+				}
+				return height, startShare, endShare, nil
+			},
+		),
+		exportProofSubCmd(
+			"blob <tx_hash> <blob_index>",
+			"Exports the proof bundle of a blob, referenced by its transaction hash, in hex format",
+			2,
+			func(ctx context.Context, trpc rpcclient.SignClient, args []string) (int64, uint64, uint64, error) {
+				txHash, err := hex.DecodeString(args[0])
+				if err != nil {
+					return 0, 0, 0, err
+				}
+				blobIndex, err := strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return 0, 0, 0, err
+				}
+				blobIndexInt, err := safeConvertInt64ToInt(blobIndex)
+				if err != nil {
+					return 0, 0, 0, err
+				}
+				return blobShareRange(ctx, trpc, txHash, blobIndexInt)
+			},
+		),
+		exportCommitmentsCmd(),
+	)
+	return command
+}
+
+func exportCommitmentsCmd() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "commitments",
+		Args:  cobra.NoArgs,
+		Short: "Exports the data commitments of the chain with their data root tuple roots computed from the chain headers, to verify proofs without an EVM node using verify --attestations-file",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config, err := parseExportFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if config.Format != FormatJSON {
+				return fmt.Errorf("commitments can only be exported as %s", FormatJSON)
+			}
+
+			logger := tmlog.NewTMLogger(os.Stderr)
+
+			trpc, err := http.New(config.TendermintRPC, "/websocket")
+			if err != nil {
+				return err
+			}
+			err = trpc.Start()
+			if err != nil {
+				return err
+			}
+			defer func(trpc *http.HTTP) {
+				err := trpc.Stop()
+				if err != nil {
+					logger.Debug("error closing connection", "err", err.Error())
+				}
+			}(trpc)
+
+			bsGRPC, err := grpc.NewClient(config.CelesGRPC, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return err
+			}
+			defer func(bsGRPC *grpc.ClientConn) {
+				err := bsGRPC.Close()
+				if err != nil {
+					logger.Debug("error closing connection", "err", err.Error())
+				}
+			}(bsGRPC)
+
+			logger.Info("exporting attested commitments")
+			commitments, err := FetchAttestedCommitments(cmd.Context(), trpc, types.NewQueryClient(bsGRPC))
+			if err != nil {
+				return err
+			}
+			if config.OutputFile == "" {
+				output, err := json.MarshalIndent(commitments, "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(output))
+				return err
+			}
+			return WriteAttestedCommitments(config.OutputFile, commitments)
+		},
+	}
+	return addExportFlags(command)
+}
+
+func exportProofSubCmd(use string, short string, numArgs int, resolve shareRangeResolver) *cobra.Command {
+	command := &cobra.Command{
+		Use:   use,
+		Args:  cobra.ExactArgs(numArgs),
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := parseExportFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			logger := tmlog.NewTMLogger(os.Stderr)
+
+			trpc, err := http.New(config.TendermintRPC, "/websocket")
+			if err != nil {
+				return err
+			}
+			err = trpc.Start()
+			if err != nil {
+				return err
+			}
+			defer func(trpc *http.HTTP) {
+				err := trpc.Stop()
+				if err != nil {
+					logger.Debug("error closing connection", "err", err.Error())
+				}
+			}(trpc)
+
+			bsGRPC, err := grpc.NewClient(config.CelesGRPC, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return err
+			}
+			defer func(bsGRPC *grpc.ClientConn) {
+				err := bsGRPC.Close()
+				if err != nil {
+					logger.Debug("error closing connection", "err", err.Error())
+				}
+			}(bsGRPC)
+
+			height, startShare, endShare, err := resolve(cmd.Context(), trpc, args)
+			if err != nil {
+				return err
+			}
+
+			logger.Info(
+				"exporting proof bundle",
+				"height",
+				height,
+				"start_share",
+				startShare,
+				"end_share",
+				endShare,
+			)
+
+			bundle, err := ExportProofBundle(cmd.Context(), trpc, types.NewQueryClient(bsGRPC), height, startShare, endShare)
+			if err != nil {
+				return err
+			}
+
+			output, err := encodeProofBundle(bundle, config.Format)
+			if err != nil {
+				return err
+			}
+			if config.OutputFile == "" {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(output))
+				return err
+			}
+			return os.WriteFile(config.OutputFile, append(output, '\n'), 0o600)
+		},
+	}
+	return addExportFlags(command)
+}
+
+func encodeProofBundle(bundle *ProofBundle, format string) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return MarshalProofBundle(bundle)
+	case FormatCalldata:
+		return []byte("0x" + hex.EncodeToString(bundle.VerifyAttestationCalldata)), nil
+	default:
+		return nil, fmt.Errorf("invalid format %q", format)
+	}
+}
@@ -0,0 +1,56 @@
+package client_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/client"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+)
+
+func (s *CLITestSuite) TestExportProofBundle() {
+	_, err := s.cctx.WaitForHeightWithTimeout(402, 2*time.Minute)
+	s.Require().NoError(err)
+
+	bundle, err := client.ExportProofBundle(s.cctx.GoContext(), s.cctx.Client, types.NewQueryClient(s.cctx.GRPCClient), 10, 0, 1)
+	s.Require().NoError(err)
+	s.Assert().Equal(uint64(2), bundle.Nonce)
+	s.Assert().Equal(uint64(1), bundle.BeginBlock)
+	s.Assert().Equal(uint64(401), bundle.EndBlock)
+
+	bz, err := client.MarshalProofBundle(bundle)
+	s.Require().NoError(err)
+	decoded, err := client.UnmarshalProofBundle(bz)
+	s.Require().NoError(err)
+	s.Assert().Equal(bundle, decoded)
+
+	contractABI, err := wrapper.WrappersMetaData.GetAbi()
+	s.Require().NoError(err)
+	method := contractABI.Methods["verifyAttestation"]
+	s.Require().Equal(method.ID, []byte(bundle.VerifyAttestationCalldata[:4]))
+	args, err := method.Inputs.Unpack(bundle.VerifyAttestationCalldata[4:])
+	s.Require().NoError(err)
+	s.Assert().Equal(big.NewInt(2), args[0])
+
+	tampered := *bundle
+	tampered.Height = 11
+	s.Assert().Error(tampered.Validate())
+	tampered = *bundle
+	tampered.DataRoot = make([]byte, 32)
+	s.Assert().Error(tampered.Validate())
+
+	cmd := client.ExportProofCmd()
+	out, err := clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, []string{
+		"shares", "10", "0", "1",
+		"--node", s.cfg.TmConfig.RPC.ListenAddress,
+		"--celes-grpc", s.cfg.AppConfig.GRPC.Address,
+		"--format", client.FormatCalldata,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(fmt.Sprintf("0x%s", hex.EncodeToString(bundle.VerifyAttestationCalldata)), strings.TrimSpace(out.String()))
+}
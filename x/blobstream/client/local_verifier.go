@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/tupleroot"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/celestiaorg/go-square/merkle"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// AttestationVerifier verifies that a data root tuple was committed to by the
+// data commitment with the provided nonce. It is implemented by the Blobstream
+// contract wrapper and by LocalBlobstream.
+type AttestationVerifier interface {
+	VerifyAttestation(
+		opts *bind.CallOpts,
+		nonce *big.Int,
+		tuple wrapper.DataRootTuple,
+		proof wrapper.BinaryMerkleProof,
+	) (bool, error)
+}
+
+var (
+	_ AttestationVerifier = &wrapper.Wrappers{}
+	_ AttestationVerifier = &LocalBlobstream{}
+)
+
+// AttestedCommitment is a data commitment along with its data root tuple root,
+// as relayed to the Blobstream contract.
+type AttestedCommitment struct {
+	Nonce             uint64           `json:"nonce"`
+	BeginBlock        uint64           `json:"begin_block"`
+	EndBlock          uint64           `json:"end_block"`
+	DataRootTupleRoot tmbytes.HexBytes `json:"data_root_tuple_root"`
+}
+
+// LocalBlobstream is a stand-in for the Blobstream contract that verifies
+// attestations against a set of attested commitments instead of the state of
+// a deployed contract. It allows verifying the bridge path without an EVM node.
+type LocalBlobstream struct {
+	commitments map[uint64]AttestedCommitment
+}
+
+// NewLocalBlobstream returns a local Blobstream contract stand-in that has
+// committed to the provided data commitments.
+func NewLocalBlobstream(commitments ...AttestedCommitment) (*LocalBlobstream, error) {
+	bs := &LocalBlobstream{commitments: make(map[uint64]AttestedCommitment, len(commitments))}
+	for _, commitment := range commitments {
+		if len(commitment.DataRootTupleRoot) != 32 {
+			return nil, fmt.Errorf("data root tuple root of nonce %d must be 32 bytes, got %d", commitment.Nonce, len(commitment.DataRootTupleRoot))
+		}
+		if commitment.BeginBlock >= commitment.EndBlock {
+			return nil, fmt.Errorf("invalid data commitment range [%d, %d) for nonce %d", commitment.BeginBlock, commitment.EndBlock, commitment.Nonce)
+		}
+		if _, exists := bs.commitments[commitment.Nonce]; exists {
+			return nil, fmt.Errorf("duplicate data commitment for nonce %d", commitment.Nonce)
+		}
+		bs.commitments[commitment.Nonce] = commitment
+	}
+	return bs, nil
+}
+
+// VerifyAttestation mirrors the verifyAttestation method of the Blobstream
+// contract: it returns true if the data root tuple is included in the data
+// root tuple root committed to at the provided nonce. Unknown nonces are not
+// an error, they return false like the contract does.
+func (bs *LocalBlobstream) VerifyAttestation(
+	_ *bind.CallOpts,
+	nonce *big.Int,
+	tuple wrapper.DataRootTuple,
+	proof wrapper.BinaryMerkleProof,
+) (bool, error) {
+	if !nonce.IsUint64() {
+		return false, nil
+	}
+	commitment, exists := bs.commitments[nonce.Uint64()]
+	if !exists {
+		return false, nil
+	}
+	if !tuple.Height.IsUint64() || !proof.Key.IsInt64() || !proof.NumLeaves.IsInt64() {
+		return false, nil
+	}
+
+	leaf, err := tupleroot.EncodeDataRootTuple(tuple.Height.Uint64(), tuple.DataRoot[:])
+	if err != nil {
+		return false, err
+	}
+	aunts := make([][]byte, len(proof.SideNodes))
+	for i, sideNode := range proof.SideNodes {
+		aunts[i] = append([]byte{}, sideNode[:]...)
+	}
+	merkleProof := merkle.Proof{
+		Total:    proof.NumLeaves.Int64(),
+		Index:    proof.Key.Int64(),
+		LeafHash: leafHash(leaf),
+		Aunts:    aunts,
+	}
+	return merkleProof.Verify(commitment.DataRootTupleRoot, leaf) == nil, nil
+}
+
+// VerifyProofBundle verifies that the proof bundle was committed to by the
+// Blobstream contract, or by a stand-in of it.
+func VerifyProofBundle(verifier AttestationVerifier, bundle *ProofBundle) (bool, error) {
+	if err := bundle.Validate(); err != nil {
+		return false, err
+	}
+	return VerifyDataRootInclusion(
+		context.Background(),
+		verifier,
+		bundle.Nonce,
+		bundle.Height,
+		bundle.DataRoot,
+		bundle.DataRootTupleProof,
+	)
+}
+
+// ComputeAttestedCommitment computes the data root tuple root of the data
+// commitment from the headers of the blocks in its range instead of relying
+// on the root computed by the node.
+func ComputeAttestedCommitment(ctx context.Context, trpc rpcclient.SignClient, dataCommitment types.DataCommitment) (AttestedCommitment, error) {
+	tree, err := tupleroot.NewDataCommitmentTree(ctx, tupleroot.RPCSource{Client: trpc}, dataCommitment)
+	if err != nil {
+		return AttestedCommitment{}, err
+	}
+	return AttestedCommitment{
+		Nonce:             dataCommitment.Nonce,
+		BeginBlock:        dataCommitment.BeginBlock,
+		EndBlock:          dataCommitment.EndBlock,
+		DataRootTupleRoot: tree.Root(),
+	}, nil
+}
+
+// FetchAttestedCommitments returns the data commitments still stored by the chain with
+// their locally computed data root tuple roots. Valsets are skipped.
+func FetchAttestedCommitments(ctx context.Context, trpc rpcclient.SignClient, queryClient types.QueryClient) ([]AttestedCommitment, error) {
+	latest, err := queryClient.LatestAttestationNonce(ctx, &types.QueryLatestAttestationNonceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	// attestations older than the earliest nonce have been pruned
+	earliest, err := queryClient.EarliestAttestationNonce(ctx, &types.QueryEarliestAttestationNonceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	commitments := make([]AttestedCommitment, 0)
+	for nonce := earliest.Nonce; nonce <= latest.Nonce; nonce++ {
+		res, err := queryClient.AttestationRequestByNonce(ctx, &types.QueryAttestationRequestByNonceRequest{Nonce: nonce})
+		if err != nil {
+			return nil, err
+		}
+		if res.Attestation == nil {
+			return nil, types.ErrNilAttestation
+		}
+		att, err := unmarshallAttestation(res.Attestation)
+		if err != nil {
+			return nil, err
+		}
+		dataCommitment, ok := att.(*types.DataCommitment)
+		if !ok {
+			continue
+		}
+		commitment, err := ComputeAttestedCommitment(ctx, trpc, *dataCommitment)
+		if err != nil {
+			return nil, err
+		}
+		commitments = append(commitments, commitment)
+	}
+	return commitments, nil
+}
+
+// WriteAttestedCommitments writes the attested commitments to a JSON file that
+// can be loaded with ReadAttestedCommitments.
+func WriteAttestedCommitments(path string, commitments []AttestedCommitment) error {
+	bz, err := json.MarshalIndent(commitments, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(bz, '\n'), 0o600)
+}
+
+// ReadAttestedCommitments reads a JSON file of attested commitments.
+func ReadAttestedCommitments(path string) ([]AttestedCommitment, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var commitments []AttestedCommitment
+	if err := json.Unmarshal(bz, &commitments); err != nil {
+		return nil, fmt.Errorf("decoding attested commitments from %s: %w", path, err)
+	}
+	return commitments, nil
+}
+
+// leafHash returns the RFC 6962 hash of a leaf, as used by the binary Merkle
+// trees of the Blobstream contract.
+func leafHash(leaf []byte) []byte {
+	h := sha256.Sum256(append([]byte{0}, leaf...))
+	return h[:]
+}
@@ -0,0 +1,74 @@
+package client_test
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/client"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+func (s *CLITestSuite) TestVerifyWithLocalBlobstream() {
+	_, err := s.cctx.WaitForHeightWithTimeout(402, 2*time.Minute)
+	s.Require().NoError(err)
+
+	queryClient := types.NewQueryClient(s.cctx.GRPCClient)
+	bundle, err := client.ExportProofBundle(s.cctx.GoContext(), s.cctx.Client, queryClient, 10, 0, 1)
+	s.Require().NoError(err)
+
+	commitments, err := client.FetchAttestedCommitments(s.cctx.GoContext(), s.cctx.Client, queryClient)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(commitments)
+	s.Require().Equal(bundle.Nonce, commitments[0].Nonce)
+	// the locally computed root matches the one computed by the node
+	s.Require().Equal(bundle.DataCommitment, commitments[0].DataRootTupleRoot)
+
+	bs, err := client.NewLocalBlobstream(commitments...)
+	s.Require().NoError(err)
+	valid, err := client.VerifyProofBundle(bs, bundle)
+	s.Require().NoError(err)
+	s.Assert().True(valid)
+
+	wrongRoot := commitments[0]
+	wrongRoot.DataRootTupleRoot = make([]byte, 32)
+	bs, err = client.NewLocalBlobstream(wrongRoot)
+	s.Require().NoError(err)
+	valid, err = client.VerifyProofBundle(bs, bundle)
+	s.Require().NoError(err)
+	s.Assert().False(valid)
+
+	bs, err = client.NewLocalBlobstream()
+	s.Require().NoError(err)
+	valid, err = client.VerifyProofBundle(bs, bundle)
+	s.Require().NoError(err)
+	s.Assert().False(valid, "unknown nonce")
+
+	attestationsFile := filepath.Join(s.T().TempDir(), "commitments.json")
+	_, err = clitestutil.ExecTestCLICmd(s.cctx.Context, client.ExportProofCmd(), []string{
+		"commitments",
+		"--node", s.cfg.TmConfig.RPC.ListenAddress,
+		"--celes-grpc", s.cfg.AppConfig.GRPC.Address,
+		"--output-file", attestationsFile,
+	})
+	s.Require().NoError(err)
+	fromFile, err := client.ReadAttestedCommitments(attestationsFile)
+	s.Require().NoError(err)
+	s.Require().Equal(commitments[0], fromFile[0])
+
+	config := client.VerifyConfig{
+		TendermintRPC: s.cfg.TmConfig.RPC.ListenAddress,
+		CelesGRPC:     s.cfg.AppConfig.GRPC.Address,
+		Local:         true,
+	}
+	committed, err := client.VerifyShares(s.cctx.GoContext(), tmlog.NewNopLogger(), config, 10, 0, 1)
+	s.Require().NoError(err)
+	s.Assert().True(committed)
+
+	config.Local = false
+	config.AttestationsFile = attestationsFile
+	committed, err = client.VerifyShares(s.cctx.GoContext(), tmlog.NewNopLogger(), config, 10, 0, 1)
+	s.Require().NoError(err)
+	s.Assert().True(committed)
+}
@@ -0,0 +1,221 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/celestiaorg/go-square/merkle"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/core"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// verifyAttestationMethod is the name of the Blobstream contract method that
+// verifies that a data root tuple was committed to by a data commitment.
+const verifyAttestationMethod = "verifyAttestation"
+
+// ProofBundle contains everything needed to prove that a range of shares was
+// committed to by the Blobstream contract, without querying Celestia again:
+//   - the share proof, from the shares to the row roots,
+//   - the row proofs, from the row roots to the data root,
+//   - the data root tuple proof, from the data root tuple to the data
+//     commitment,
+//   - the nonce and the range of blocks of the data commitment.
+//
+// VerifyAttestationCalldata is the ABI encoded call to verifyAttestation that
+// can be sent as is to the Blobstream contract.
+type ProofBundle struct {
+	Height         int64            `json:"height"`
+	DataRoot       tmbytes.HexBytes `json:"data_root"`
+	Nonce          uint64           `json:"nonce"`
+	BeginBlock     uint64           `json:"begin_block"`
+	EndBlock       uint64           `json:"end_block"`
+	DataCommitment tmbytes.HexBytes `json:"data_commitment"`
+	// ShareProof contains both the NMT proofs of the shares to the row roots
+	// and the Merkle proofs of the row roots to the data root.
+	ShareProof tmtypes.ShareProof `json:"share_proof"`
+	// DataRootTupleProof is the Merkle proof of the data root tuple to the
+	// data commitment.
+	DataRootTupleProof        merkle.Proof     `json:"data_root_tuple_proof"`
+	VerifyAttestationCalldata tmbytes.HexBytes `json:"verify_attestation_calldata"`
+}
+
+// ExportProofBundle queries the share proof of the range of shares at the
+// provided height, the data commitment that contains that height and the
+// proof of the data root tuple to that data commitment, and returns them as a
+// validated proof bundle. The range of shares is end exclusive.
+func ExportProofBundle(
+	ctx context.Context,
+	trpc rpcclient.SignClient,
+	queryClient types.QueryClient,
+	height int64,
+	startShare uint64,
+	endShare uint64,
+) (*ProofBundle, error) {
+	if height <= 0 {
+		return nil, fmt.Errorf("height must be a positive integer")
+	}
+	unsignedHeight := uint64(height)
+
+	header, err := trpc.Header(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	sharesProof, err := trpc.ProveSharesV2(ctx, unsignedHeight, startShare, endShare)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := queryClient.DataCommitmentRangeForHeight(
+		ctx,
+		&types.QueryDataCommitmentRangeForHeightRequest{Height: unsignedHeight},
+	)
+	if err != nil {
+		return nil, err
+	}
+	dataCommitment := resp.DataCommitment
+
+	commitment, err := trpc.DataCommitment(ctx, dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	dcProof, err := trpc.DataRootInclusionProof(ctx, unsignedHeight, dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &ProofBundle{
+		Height:         height,
+		DataRoot:       header.Header.DataHash,
+		Nonce:          dataCommitment.Nonce,
+		BeginBlock:     dataCommitment.BeginBlock,
+		EndBlock:       dataCommitment.EndBlock,
+		DataCommitment: commitment.DataCommitment,
+		ShareProof:     sharesProof.ShareProof,
+		DataRootTupleProof: merkle.Proof{
+			Total:    dcProof.Proof.Total,
+			Index:    dcProof.Proof.Index,
+			LeafHash: dcProof.Proof.LeafHash,
+			Aunts:    dcProof.Proof.Aunts,
+		},
+	}
+	bundle.VerifyAttestationCalldata, err = PackVerifyAttestation(bundle.Nonce, bundle.Height, bundle.DataRoot, bundle.DataRootTupleProof)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bundle.Validate(); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// Validate checks that the proofs of the bundle are consistent with each other
+// and with the data root and the data commitment of the bundle. It doesn't
+// check that the data commitment was committed to by the Blobstream contract.
+func (b ProofBundle) Validate() error {
+	if b.Height <= 0 {
+		return fmt.Errorf("height must be a positive integer")
+	}
+	if len(b.DataRoot) != 32 {
+		return fmt.Errorf("data root must be 32 bytes, got %d", len(b.DataRoot))
+	}
+	if uint64(b.Height) < b.BeginBlock || uint64(b.Height) >= b.EndBlock {
+		return fmt.Errorf("height %d is not in the data commitment range [%d, %d)", b.Height, b.BeginBlock, b.EndBlock)
+	}
+	if err := b.ShareProof.Validate(b.DataRoot); err != nil {
+		return fmt.Errorf("invalid share proof: %w", err)
+	}
+
+	if b.DataRootTupleProof.Total != int64(b.EndBlock-b.BeginBlock) {
+		return fmt.Errorf("data root tuple proof total %d doesn't match the data commitment range [%d, %d)", b.DataRootTupleProof.Total, b.BeginBlock, b.EndBlock)
+	}
+	if b.DataRootTupleProof.Index != int64(uint64(b.Height)-b.BeginBlock) {
+		return fmt.Errorf("data root tuple proof index %d doesn't match height %d", b.DataRootTupleProof.Index, b.Height)
+	}
+	tuple, err := core.EncodeDataRootTuple(uint64(b.Height), *(*[32]byte)(b.DataRoot))
+	if err != nil {
+		return err
+	}
+	if err := b.DataRootTupleProof.Verify(b.DataCommitment, tuple); err != nil {
+		return fmt.Errorf("invalid data root tuple proof: %w", err)
+	}
+
+	calldata, err := PackVerifyAttestation(b.Nonce, b.Height, b.DataRoot, b.DataRootTupleProof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(calldata, b.VerifyAttestationCalldata) {
+		return fmt.Errorf("verifyAttestation calldata doesn't match the proof bundle")
+	}
+	return nil
+}
+
+// MarshalProofBundle returns the JSON encoding of the proof bundle.
+func MarshalProofBundle(bundle *ProofBundle) ([]byte, error) {
+	return json.MarshalIndent(bundle, "", "  ")
+}
+
+// UnmarshalProofBundle decodes a JSON encoded proof bundle and validates it.
+func UnmarshalProofBundle(bz []byte) (*ProofBundle, error) {
+	var bundle ProofBundle
+	if err := json.Unmarshal(bz, &bundle); err != nil {
+		return nil, fmt.Errorf("decoding proof bundle: %w", err)
+	}
+	if err := bundle.Validate(); err != nil {
+		return nil, err
+	}
+	return &bundle, nil
+}
+
+// PackVerifyAttestation returns the ABI encoded call to the verifyAttestation
+// method of the Blobstream contract, including the method selector.
+func PackVerifyAttestation(nonce uint64, height int64, dataRoot []byte, proof merkle.Proof) (tmbytes.HexBytes, error) {
+	if len(dataRoot) != 32 {
+		return nil, fmt.Errorf("data root must be 32 bytes, got %d", len(dataRoot))
+	}
+	wrappedProof, err := newBinaryMerkleProof(proof)
+	if err != nil {
+		return nil, err
+	}
+	contractABI, err := wrapper.WrappersMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return contractABI.Pack(
+		verifyAttestationMethod,
+		new(big.Int).SetUint64(nonce),
+		newDataRootTuple(height, dataRoot),
+		wrappedProof,
+	)
+}
+
+func newDataRootTuple(height int64, dataRoot []byte) wrapper.DataRootTuple {
+	return wrapper.DataRootTuple{
+		Height:   big.NewInt(height),
+		DataRoot: *(*[32]byte)(dataRoot),
+	}
+}
+
+func newBinaryMerkleProof(proof merkle.Proof) (wrapper.BinaryMerkleProof, error) {
+	sideNodes := make([][32]byte, len(proof.Aunts))
+	for i, aunt := range proof.Aunts {
+		if len(aunt) != 32 {
+			return wrapper.BinaryMerkleProof{}, fmt.Errorf("side node %d must be 32 bytes, got %d", i, len(aunt))
+		}
+		sideNodes[i] = *(*[32]byte)(aunt)
+	}
+	return wrapper.BinaryMerkleProof{
+		SideNodes: sideNodes,
+		Key:       big.NewInt(proof.Index),
+		NumLeaves: big.NewInt(proof.Total),
+	}, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/cobra"
 	tmlog "github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/client/http"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -70,28 +71,14 @@ func txCmd() *cobra.Command {
 				}
 			}(trpc)
 
-			tx, err := trpc.Tx(cmd.Context(), txHash, true)
-			if err != nil {
-				return err
-			}
-
-			logger.Info("verifying that the transaction was committed to by the Blobstream", "tx_hash", args[0], "height", tx.Height)
-
-			blockRes, err := trpc.Block(cmd.Context(), &tx.Height)
-			if err != nil {
-				return err
-			}
-
-			version := blockRes.Block.Header.Version.App
-			maxSquareSize := appconsts.SquareSizeUpperBound(version)
-			subtreeRootThreshold := appconsts.SubtreeRootThreshold(version)
+			logger.Info("verifying that the transaction was committed to by the Blobstream", "tx_hash", args[0])
 
-			shareRange, err := square.TxShareRange(blockRes.Block.Data.Txs.ToSliceOfBytes(), int(tx.Index), maxSquareSize, subtreeRootThreshold)
+			height, startShare, endShare, err := txShareRange(cmd.Context(), trpc, txHash)
 			if err != nil {
 				return err
 			}
 
-			_, err = VerifyShares(cmd.Context(), logger, config, tx.Height, uint64(shareRange.Start), uint64(shareRange.End))
+			_, err = VerifyShares(cmd.Context(), logger, config, height, startShare, endShare)
 			return err
 		},
 	}
@@ -140,27 +127,14 @@ func blobCmd() *cobra.Command {
 				}
 			}(trpc)
 
-			tx, err := trpc.Tx(cmd.Context(), txHash, true)
-			if err != nil {
-				return err
-			}
-
-			logger.Info("verifying that the blob was committed to by the Blobstream", "tx_hash", args[0], "height", tx.Height)
+			logger.Info("verifying that the blob was committed to by the Blobstream", "tx_hash", args[0], "blob_index", blobIndexInt)
 
-			blockRes, err := trpc.Block(cmd.Context(), &tx.Height)
+			height, startShare, endShare, err := blobShareRange(cmd.Context(), trpc, txHash, blobIndexInt)
 			if err != nil {
 				return err
 			}
 
-			version := blockRes.Block.Header.Version.App
-			maxSquareSize := appconsts.SquareSizeUpperBound(version)
-			subtreeRootThreshold := appconsts.SubtreeRootThreshold(version)
-			blobShareRange, err := square.BlobShareRange(blockRes.Block.Txs.ToSliceOfBytes(), int(tx.Index), blobIndexInt, maxSquareSize, subtreeRootThreshold)
-			if err != nil {
-				return err
-			}
-
-			_, err = VerifyShares(cmd.Context(), logger, config, tx.Height, uint64(blobShareRange.Start), uint64(blobShareRange.End))
+			_, err = VerifyShares(cmd.Context(), logger, config, height, startShare, endShare)
 			return err
 		},
 	}
@@ -295,21 +269,16 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 		return false, err
 	}
 
-	ethClient, err := ethclient.Dial(config.EVMRPC)
-	if err != nil {
-		return false, err
-	}
-	defer ethClient.Close()
-
-	bsWrapper, err := wrapper.NewWrappers(config.ContractAddr, ethClient)
+	verifier, closeVerifier, err := newAttestationVerifier(ctx, config, trpc, *resp.DataCommitment)
 	if err != nil {
 		return false, err
 	}
+	defer closeVerifier()
 
 	logger.Info("verifying that the data root was committed to in the Blobstream contract")
 	isCommittedTo, err = VerifyDataRootInclusion(
 		ctx,
-		bsWrapper,
+		verifier,
 		resp.DataCommitment.Nonce,
 		height,
 		block.Block.DataHash,
@@ -335,31 +304,21 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 
 func VerifyDataRootInclusion(
 	_ context.Context,
-	bsWrapper *wrapper.Wrappers,
+	verifier AttestationVerifier,
 	nonce uint64,
 	height int64,
 	dataRoot []byte,
 	proof merkle.Proof,
 ) (bool, error) {
-	tuple := wrapper.DataRootTuple{
-		Height:   big.NewInt(height),
-		DataRoot: *(*[32]byte)(dataRoot),
-	}
-
-	sideNodes := make([][32]byte, len(proof.Aunts))
-	for i, aunt := range proof.Aunts {
-		sideNodes[i] = *(*[32]byte)(aunt)
-	}
-	wrappedProof := wrapper.BinaryMerkleProof{
-		SideNodes: sideNodes,
-		Key:       big.NewInt(proof.Index),
-		NumLeaves: big.NewInt(proof.Total),
+	wrappedProof, err := newBinaryMerkleProof(proof)
+	if err != nil {
+		return false, err
 	}
 
-	valid, err := bsWrapper.VerifyAttestation(
+	valid, err := verifier.VerifyAttestation(
 		&bind.CallOpts{},
 		big.NewInt(int64(nonce)),
-		tuple,
+		newDataRootTuple(height, dataRoot),
 		wrappedProof,
 	)
 	if err != nil {
@@ -368,6 +327,97 @@ func VerifyDataRootInclusion(
 	return valid, nil
 }
 
+// txShareRange returns the height of the block that contains the transaction
+// and the end exclusive range of shares the transaction occupies in it.
+func txShareRange(ctx context.Context, trpc rpcclient.SignClient, txHash []byte) (height int64, startShare uint64, endShare uint64, err error) {
+	tx, err := trpc.Tx(ctx, txHash, true)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	blockRes, err := trpc.Block(ctx, &tx.Height)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	version := blockRes.Block.Header.Version.App
+	maxSquareSize := appconsts.SquareSizeUpperBound(version)
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(version)
+
+	shareRange, err := square.TxShareRange(blockRes.Block.Data.Txs.ToSliceOfBytes(), int(tx.Index), maxSquareSize, subtreeRootThreshold)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return tx.Height, uint64(shareRange.Start), uint64(shareRange.End), nil
+}
+
+// blobShareRange returns the height of the block that contains the blob
+// transaction and the end exclusive range of shares the blob at the provided
+// index occupies in it.
+func blobShareRange(ctx context.Context, trpc rpcclient.SignClient, txHash []byte, blobIndex int) (height int64, startShare uint64, endShare uint64, err error) {
+	tx, err := trpc.Tx(ctx, txHash, true)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	blockRes, err := trpc.Block(ctx, &tx.Height)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	version := blockRes.Block.Header.Version.App
+	maxSquareSize := appconsts.SquareSizeUpperBound(version)
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(version)
+
+	shareRange, err := square.BlobShareRange(blockRes.Block.Txs.ToSliceOfBytes(), int(tx.Index), blobIndex, maxSquareSize, subtreeRootThreshold)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return tx.Height, uint64(shareRange.Start), uint64(shareRange.End), nil
+}
+
+// newAttestationVerifier returns the verifier of the data root inclusion
+// proofs. It is the deployed Blobstream contract unless the verifier is
+// configured to run offline, in which case it is a local stand-in of the
+// contract that has committed to the attested commitments file, or to the
+// data commitment recomputed from the chain. The returned function releases
+// the resources of the verifier.
+func newAttestationVerifier(ctx context.Context, config VerifyConfig, trpc rpcclient.SignClient, dataCommitment types.DataCommitment) (AttestationVerifier, func(), error) {
+	switch {
+	case config.AttestationsFile != "":
+		commitments, err := ReadAttestedCommitments(config.AttestationsFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		bs, err := NewLocalBlobstream(commitments...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return bs, func() {}, nil
+	case config.Local:
+		commitment, err := ComputeAttestedCommitment(ctx, trpc, dataCommitment)
+		if err != nil {
+			return nil, nil, err
+		}
+		bs, err := NewLocalBlobstream(commitment)
+		if err != nil {
+			return nil, nil, err
+		}
+		return bs, func() {}, nil
+	default:
+		ethClient, err := ethclient.Dial(config.EVMRPC)
+		if err != nil {
+			return nil, nil, err
+		}
+		bsWrapper, err := wrapper.NewWrappers(config.ContractAddr, ethClient)
+		if err != nil {
+			ethClient.Close()
+			return nil, nil, err
+		}
+		return bsWrapper, ethClient.Close, nil
+	}
+}
+
 func safeConvertInt64ToInt(x int64) (int, error) {
 	if x < math.MinInt {
 		return 0, fmt.Errorf("value %d is too small to be converted to int", x)
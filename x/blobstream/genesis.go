@@ -31,5 +31,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params.DataCommitmentWindow = k.GetDataCommitmentWindowParam(ctx)
+	genesis.Params.AttestationExpiryTime = k.GetAttestationExpiryTimeParam(ctx)
+	genesis.Params.SignificantPowerDifferenceThreshold = k.GetSignificantPowerDifferenceThresholdParam(ctx)
 	return genesis
 }
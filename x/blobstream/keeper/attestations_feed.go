@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// attestationsFeedBufferSize is the number of attestations that can be
+	// queued for a subscriber before it is considered too slow and dropped.
+	attestationsFeedBufferSize = 100
+	// attestationsFeedRecentSize is the number of the latest published
+	// attestations kept in memory. They are handed to the new subscribers so
+	// that they don't miss the attestations that were published while the
+	// committed ones were being read.
+	attestationsFeedRecentSize = 100
+)
+
+// QueryContextProvider creates a context to query the committed state at the
+// provided height. A zero height means the latest committed height.
+type QueryContextProvider func(height int64, prove bool) (sdk.Context, error)
+
+// attestationsFeed fans out the attestations created in EndBlocker to the
+// AttestationsStream subscribers once their block is committed.
+type attestationsFeed struct {
+	mu          sync.Mutex
+	subscribers map[chan types.AttestationRequestI]struct{}
+	// pending contains the attestations created in the current block. They
+	// are published after the block is committed.
+	pending []types.AttestationRequestI
+	// recent contains the latest published attestations in ascending nonce
+	// order.
+	recent []types.AttestationRequestI
+	// queryContext is used to read the attestations that were committed before
+	// a subscription was opened. Nil if not set by the application.
+	queryContext QueryContextProvider
+}
+
+func newAttestationsFeed() *attestationsFeed {
+	return &attestationsFeed{
+		subscribers: make(map[chan types.AttestationRequestI]struct{}),
+	}
+}
+
+// SetQueryContextProvider sets the provider used by AttestationsStream to read
+// the attestations committed before the stream was opened. Without it, only
+// the attestations created after the stream is opened can be streamed.
+func (k Keeper) SetQueryContextProvider(provider QueryContextProvider) {
+	if k.attestationsFeed == nil {
+		return
+	}
+	k.attestationsFeed.mu.Lock()
+	defer k.attestationsFeed.mu.Unlock()
+	k.attestationsFeed.queryContext = provider
+}
+
+// PublishCommittedAttestations sends the attestations created in the last
+// block to the AttestationsStream subscribers. It must be called after the
+// block is committed so that subscribers never see uncommitted state.
+func (k Keeper) PublishCommittedAttestations() {
+	k.attestationsFeed.publishPending()
+}
+
+// DiscardUncommittedAttestations drops the attestations that were created
+// but not published, i.e. the ones of a block that was never committed.
+func (k Keeper) DiscardUncommittedAttestations() {
+	k.attestationsFeed.discardPending()
+}
+
+// queue adds the attestation to the ones published after the block is
+// committed.
+func (f *attestationsFeed) queue(at types.AttestationRequestI) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pending = append(f.pending, at)
+}
+
+// discardPending drops the queued attestations.
+func (f *attestationsFeed) discardPending() {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pending = nil
+}
+
+// publishPending publishes the queued attestations in the order they were
+// created.
+func (f *attestationsFeed) publishPending() {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, at := range f.pending {
+		f.publish(at)
+	}
+	f.pending = nil
+}
+
+// publish sends the attestation to all the subscribers. Subscribers that are
+// not keeping up are dropped by closing their channel. The caller must hold
+// the lock.
+func (f *attestationsFeed) publish(at types.AttestationRequestI) {
+	f.recent = append(f.recent, at)
+	if len(f.recent) > attestationsFeedRecentSize {
+		f.recent = f.recent[len(f.recent)-attestationsFeedRecentSize:]
+	}
+
+	for sub := range f.subscribers {
+		select {
+		case sub <- at:
+		default:
+			delete(f.subscribers, sub)
+			close(sub)
+		}
+	}
+}
+
+// subscribe registers a new subscriber and returns its channel along with a
+// copy of the latest published attestations and the query context provider.
+func (f *attestationsFeed) subscribe() (chan types.AttestationRequestI, []types.AttestationRequestI, QueryContextProvider) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sub := make(chan types.AttestationRequestI, attestationsFeedBufferSize)
+	f.subscribers[sub] = struct{}{}
+	recent := make([]types.AttestationRequestI, len(f.recent))
+	copy(recent, f.recent)
+	return sub, recent, f.queryContext
+}
+
+// unsubscribe removes the subscriber. It does nothing if the subscriber was
+// already dropped.
+func (f *attestationsFeed) unsubscribe(sub chan types.AttestationRequestI) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.subscribers[sub]; ok {
+		delete(f.subscribers, sub)
+		close(sub)
+	}
+}
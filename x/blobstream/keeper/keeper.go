@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,6 +20,11 @@ type Keeper struct {
 	paramSpace paramtypes.Subspace
 
 	StakingKeeper StakingKeeper
+
+	// attestationsFeed notifies the AttestationsStream subscribers of the
+	// attestations created in EndBlocker. It is a pointer so that all the
+	// copies of the keeper share the same subscribers.
+	attestationsFeed *attestationsFeed
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper StakingKeeper) *Keeper {
@@ -28,16 +34,18 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace p
 	}
 
 	return &Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		StakingKeeper: stakingKeeper,
-		paramSpace:    paramSpace,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		StakingKeeper:    stakingKeeper,
+		paramSpace:       paramSpace,
+		attestationsFeed: newAttestationsFeed(),
 	}
 }
 
-// GetParams returns the parameters from the store
+// GetParams returns the parameters from the store. The params that are not in
+// the store, e.g. once the module is disabled, are left empty.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
@@ -46,6 +54,23 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
+// GetAttestationExpiryTimeParam returns the time after which an attestation is
+// pruned from state.
+func (k Keeper) GetAttestationExpiryTimeParam(ctx sdk.Context) time.Duration {
+	var expiryTime time.Duration
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyAttestationExpiryTime, &expiryTime)
+	return expiryTime
+}
+
+// GetSignificantPowerDifferenceThresholdParam returns the threshold of change
+// in the validator set power that triggers the creation of a new valset
+// request.
+func (k Keeper) GetSignificantPowerDifferenceThresholdParam(ctx sdk.Context) sdk.Dec {
+	var threshold sdk.Dec
+	k.paramSpace.Get(ctx, types.ParamsStoreKeySignificantPowerDifferenceThreshold, &threshold)
+	return threshold
+}
+
 // DeserializeValidatorIterator returns validators from the validator iterator.
 // Adding here in Blobstream keeper as cdc is not available inside endblocker.
 func (k Keeper) DeserializeValidatorIterator(vals []byte) stakingtypes.ValAddresses {
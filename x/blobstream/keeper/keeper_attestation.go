@@ -1,10 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
+
+	"cosmossdk.io/errors"
 
 	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // SetAttestationRequest sets a new attestation request to the store to be
@@ -12,6 +18,7 @@ import (
 func (k Keeper) SetAttestationRequest(ctx sdk.Context, at types.AttestationRequestI) error {
 	k.StoreAttestation(ctx, at)
 	k.SetLatestAttestationNonce(ctx, at.GetNonce())
+	k.attestationsFeed.queue(at)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -137,3 +144,168 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, nonce uint64) {
 	}
 	store.Delete(key)
 }
+
+// IterateAttestations iterates over the attestations in store in ascending
+// nonce order and calls cb on each of them until cb returns true.
+func (k Keeper) IterateAttestations(ctx sdk.Context, cb func(types.AttestationRequestI) (stop bool)) error {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(types.AttestationRequestKey))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var at types.AttestationRequestI
+		if err := k.cdc.UnmarshalInterface(iterator.Value(), &at); err != nil {
+			return types.ErrUnmarshalllAttestation
+		}
+		if cb(at) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// PaginateAttestations returns the page of the attestations in store with a
+// nonce in [beginNonce, endNonce), in ascending nonce order unless the page
+// request is reversed, for which filter returns true. An endNonce of zero
+// leaves the range open and a nil filter selects all the attestations in the
+// range.
+func (k Keeper) PaginateAttestations(
+	ctx sdk.Context,
+	beginNonce, endNonce uint64,
+	pageRequest *query.PageRequest,
+	filter func(types.AttestationRequestI) bool,
+) ([]types.AttestationRequestI, *query.PageResponse, error) {
+	store := nonceRangeStore{
+		KVStore: prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AttestationRequestKey)),
+		begin:   types.UInt64Bytes(beginNonce),
+	}
+	if endNonce != 0 {
+		store.end = types.UInt64Bytes(endNonce)
+	}
+	var attestations []types.AttestationRequestI
+	pageResponse, err := query.FilteredPaginate(store, pageRequest, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var at types.AttestationRequestI
+		if err := k.cdc.UnmarshalInterface(value, &at); err != nil {
+			return false, types.ErrUnmarshalllAttestation
+		}
+		if filter != nil && !filter(at) {
+			return false, nil
+		}
+		if accumulate {
+			attestations = append(attestations, at)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return attestations, pageResponse, nil
+}
+
+// AttestationNoncesForHeightRange returns the [beginNonce, endNonce) range of
+// the attestations that can overlap the [beginHeight, endHeight) height range.
+// The attestation heights, i.e. the valset heights and the data commitment
+// end blocks, increase with the nonce, so both bounds are binary searched
+// instead of scanning the store. The range may contain attestations that don't
+// overlap the height range at its boundaries, so the callers still need to
+// filter them.
+func (k Keeper) AttestationNoncesForHeightRange(ctx sdk.Context, beginHeight, endHeight uint64) (uint64, uint64, error) {
+	if !k.CheckLatestAttestationNonce(ctx) || !k.CheckEarliestAvailableAttestationNonce(ctx) {
+		return 0, 0, nil
+	}
+	earliest := k.GetEarliestAvailableAttestationNonce(ctx)
+	latest := k.GetLatestAttestationNonce(ctx)
+	if earliest > latest {
+		return 0, 0, nil
+	}
+
+	beginNonce, err := k.searchAttestationHeight(ctx, earliest, latest, beginHeight)
+	if err != nil {
+		return 0, 0, err
+	}
+	// the first attestation at or above the end height can still be a data
+	// commitment starting inside the range. The ones after the next data
+	// commitment can't overlap the range.
+	endNonce, err := k.searchAttestationHeight(ctx, earliest, latest, endHeight)
+	if err != nil {
+		return 0, 0, err
+	}
+	for ; endNonce <= latest; endNonce++ {
+		at, found, err := k.GetAttestationByNonce(ctx, endNonce)
+		if err != nil {
+			return 0, 0, err
+		}
+		if !found {
+			return 0, 0, errors.Wrapf(types.ErrAttestationNotFound, "nonce %d", endNonce)
+		}
+		if _, ok := at.(*types.DataCommitment); ok {
+			endNonce++
+			break
+		}
+	}
+	return beginNonce, endNonce, nil
+}
+
+// searchAttestationHeight returns the smallest nonce in [earliest, latest]
+// whose attestation height is greater than or equal to the provided height, or
+// latest + 1 if there is none.
+func (k Keeper) searchAttestationHeight(ctx sdk.Context, earliest, latest, height uint64) (uint64, error) {
+	var searchErr error
+	i := sort.Search(int(latest-earliest+1), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		nonce := earliest + uint64(i)
+		at, found, err := k.GetAttestationByNonce(ctx, nonce)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		if !found {
+			searchErr = errors.Wrapf(types.ErrAttestationNotFound, "nonce %d", nonce)
+			return true
+		}
+		switch at := at.(type) {
+		case *types.Valset:
+			return at.Height >= height
+		case *types.DataCommitment:
+			return at.EndBlock >= height
+		default:
+			return false
+		}
+	})
+	if searchErr != nil {
+		return 0, searchErr
+	}
+	return earliest + uint64(i), nil
+}
+
+// nonceRangeStore restricts the iterators of the attestations store to the
+// nonces in [begin, end) so that pagination seeks to the first nonce of the
+// range instead of scanning the whole store. A nil end leaves the range open.
+type nonceRangeStore struct {
+	sdk.KVStore
+	begin, end []byte
+}
+
+func (s nonceRangeStore) Iterator(start, end []byte) sdk.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s nonceRangeStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s nonceRangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if start == nil || bytes.Compare(start, s.begin) < 0 {
+		start = s.begin
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	// a range outside of the bounds becomes an empty one instead of an invalid one
+	if end != nil && bytes.Compare(start, end) > 0 {
+		end = start
+	}
+	return start, end
+}
@@ -42,11 +42,9 @@ func (k Keeper) NextDataCommitment(ctx sdk.Context) (types.DataCommitment, error
 }
 
 func (k Keeper) GetDataCommitmentWindowParam(ctx sdk.Context) uint64 {
-	resp, err := k.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
-	if err != nil {
-		panic(err)
-	}
-	return resp.Params.DataCommitmentWindow
+	var window uint64
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyDataCommitmentWindow, &window)
+	return window
 }
 
 // GetDataCommitmentForHeight returns the attestation containing the provided height.
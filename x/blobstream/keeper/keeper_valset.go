@@ -219,6 +219,20 @@ func (k Keeper) GetEVMAddress(ctx sdk.Context, valAddress sdk.ValAddress) (gethc
 	return gethcommon.BytesToAddress(addrBytes), true
 }
 
+// IterateEVMAddresses iterates over the registered EVM addresses ordered by
+// validator address and calls cb on each of them until cb returns true.
+func (k Keeper) IterateEVMAddresses(ctx sdk.Context, cb func(valAddress sdk.ValAddress, evmAddress gethcommon.Address) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.EVMAddress))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		valAddress := sdk.ValAddress(iterator.Key()[len(types.EVMAddress):])
+		if cb(valAddress, gethcommon.BytesToAddress(iterator.Value())) {
+			return
+		}
+	}
+}
+
 // IsEVMAddressUnique checks if the provided evm address is globally unique. This
 // includes the defaults we set validators when they initially create a validator
 // before registering
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3. It sets the attestation expiry
+// time and the significant power difference threshold params to the values
+// that were hardcoded before they became params.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamsStoreKeyAttestationExpiryTime, types.DefaultAttestationExpiryTime)
+	m.keeper.paramSpace.Set(ctx, types.ParamsStoreKeySignificantPowerDifferenceThreshold, types.DefaultSignificantPowerDifferenceThreshold)
+	return nil
+}
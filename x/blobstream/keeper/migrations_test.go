@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/keeper"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	input := testutil.CreateTestEnv(t)
+	k := input.BlobstreamKeeper
+
+	params := types.DefaultParams()
+	params.AttestationExpiryTime = time.Hour
+	params.SignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(1, 1)
+	k.SetParams(input.Context, params)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(input.Context))
+
+	// the migration sets the values that were used before they became params
+	assert.Equal(t, 3*7*24*time.Hour, k.GetAttestationExpiryTimeParam(input.Context))
+	assert.Equal(t, sdk.NewDecWithPrec(5, 2), k.GetSignificantPowerDifferenceThresholdParam(input.Context))
+	assert.Equal(t, params.DataCommitmentWindow, k.GetDataCommitmentWindowParam(input.Context))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
@@ -48,3 +49,196 @@ func (k Keeper) LatestAttestationNonce(
 		Nonce: k.GetLatestAttestationNonce(sdk.UnwrapSDKContext(ctx)),
 	}, nil
 }
+
+func (k Keeper) AttestationsByNonceRange(
+	ctx context.Context,
+	request *types.QueryAttestationsByNonceRangeRequest,
+) (*types.QueryAttestationsByNonceRangeResponse, error) {
+	if request.EndNonce != 0 && request.BeginNonce >= request.EndNonce {
+		return nil, errors.Wrapf(
+			types.ErrInvalidRange,
+			"begin nonce %d >= end nonce %d",
+			request.BeginNonce,
+			request.EndNonce,
+		)
+	}
+	attestations, pageResponse, err := k.PaginateAttestations(
+		sdk.UnwrapSDKContext(ctx),
+		request.BeginNonce,
+		request.EndNonce,
+		request.Pagination,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	anys, err := attestationsToAnys(attestations)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttestationsByNonceRangeResponse{
+		Attestations: anys,
+		Pagination:   pageResponse,
+	}, nil
+}
+
+func (k Keeper) AttestationsByHeightRange(
+	ctx context.Context,
+	request *types.QueryAttestationsByHeightRangeRequest,
+) (*types.QueryAttestationsByHeightRangeResponse, error) {
+	if request.BeginHeight >= request.EndHeight {
+		return nil, errors.Wrapf(
+			types.ErrInvalidRange,
+			"begin height %d >= end height %d",
+			request.BeginHeight,
+			request.EndHeight,
+		)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	beginNonce, endNonce, err := k.AttestationNoncesForHeightRange(sdkCtx, request.BeginHeight, request.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+	attestations, pageResponse, err := k.PaginateAttestations(
+		sdkCtx,
+		beginNonce,
+		endNonce,
+		request.Pagination,
+		func(at types.AttestationRequestI) bool {
+			switch at := at.(type) {
+			case *types.Valset:
+				return at.Height >= request.BeginHeight && at.Height < request.EndHeight
+			case *types.DataCommitment:
+				return at.OverlapsHeightRange(request.BeginHeight, request.EndHeight)
+			default:
+				return false
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	anys, err := attestationsToAnys(attestations)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttestationsByHeightRangeResponse{
+		Attestations: anys,
+		Pagination:   pageResponse,
+	}, nil
+}
+
+// AttestationsStream sends the attestations starting from the requested nonce,
+// then the new ones as they are created in EndBlocker, until the client closes
+// the stream. Slow clients are disconnected.
+func (k Keeper) AttestationsStream(
+	request *types.QueryAttestationsStreamRequest,
+	stream types.Query_AttestationsStreamServer,
+) error {
+	if k.attestationsFeed == nil {
+		return types.ErrAttestationsStreamUnavailable
+	}
+	sub, recent, queryContext := k.attestationsFeed.subscribe()
+	defer k.attestationsFeed.unsubscribe(sub)
+
+	// nextNonce is the nonce of the next attestation to send. Zero means
+	// that any new attestation can be sent.
+	nextNonce := request.StartNonce
+	send := func(at types.AttestationRequestI) error {
+		if nextNonce != 0 && at.GetNonce() != nextNonce {
+			return errors.Wrapf(
+				types.ErrAttestationNotFound,
+				"expected nonce %d, got %d",
+				nextNonce,
+				at.GetNonce(),
+			)
+		}
+		val, err := codectypes.NewAnyWithValue(at)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&types.QueryAttestationsStreamResponse{Attestation: val}); err != nil {
+			return err
+		}
+		nextNonce = at.GetNonce() + 1
+		return nil
+	}
+
+	if nextNonce != 0 {
+		if queryContext == nil {
+			return errors.Wrap(
+				types.ErrAttestationsStreamUnavailable,
+				"cannot stream the attestations created before opening the stream",
+			)
+		}
+		sdkCtx, err := queryContext(0, false)
+		if err != nil {
+			return err
+		}
+		if k.CheckLatestAttestationNonce(sdkCtx) {
+			if !k.CheckEarliestAvailableAttestationNonce(sdkCtx) {
+				return types.ErrEarliestAvailableNonceStillNotInitialized
+			}
+			if earliest := k.GetEarliestAvailableAttestationNonce(sdkCtx); nextNonce < earliest {
+				return errors.Wrapf(
+					types.ErrAttestationNotFound,
+					"nonce %d was pruned, earliest available nonce is %d",
+					nextNonce,
+					earliest,
+				)
+			}
+			for latest := k.GetLatestAttestationNonce(sdkCtx); nextNonce <= latest; {
+				at, found, err := k.GetAttestationByNonce(sdkCtx, nextNonce)
+				if err != nil {
+					return err
+				}
+				if !found {
+					return errors.Wrapf(types.ErrAttestationNotFound, "nonce %d", nextNonce)
+				}
+				if err := send(at); err != nil {
+					return err
+				}
+			}
+		}
+		// the recent attestations may have been created after the query
+		// context height.
+		for _, at := range recent {
+			if at.GetNonce() < nextNonce {
+				continue
+			}
+			if err := send(at); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case at, ok := <-sub:
+			if !ok {
+				return errors.Wrap(types.ErrAttestationsStreamUnavailable, "stream is not keeping up with the new attestations")
+			}
+			if nextNonce != 0 && at.GetNonce() < nextNonce {
+				continue
+			}
+			if err := send(at); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// attestationsToAnys packs the attestations into Any values.
+func attestationsToAnys(attestations []types.AttestationRequestI) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, 0, len(attestations))
+	for _, at := range attestations {
+		val, err := codectypes.NewAnyWithValue(at)
+		if err != nil {
+			return nil, err
+		}
+		anys = append(anys, val)
+	}
+	return anys, nil
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// setupAttestations sets a valset at nonce 1 and height 1, then ten data
+// commitments covering the [1, 1001) height range by windows of 100 blocks.
+func setupAttestations(t *testing.T) (testutil.TestInput, sdk.Context) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	vs, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	vs.Height = 1
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &vs))
+
+	for i := uint64(0); i < 10; i++ {
+		dc := types.NewDataCommitment(i+2, 100*i+1, 100*(i+1)+1, time.Now())
+		require.NoError(t, k.SetAttestationRequest(sdkCtx, dc))
+	}
+	return input, sdkCtx
+}
+
+func attestationNonces(t *testing.T, input testutil.TestInput, anys []*codectypes.Any) []uint64 {
+	nonces := make([]uint64, 0, len(anys))
+	for _, val := range anys {
+		var at types.AttestationRequestI
+		require.NoError(t, input.Marshaler.UnpackAny(val, &at))
+		nonces = append(nonces, at.GetNonce())
+	}
+	return nonces
+}
+
+func TestAttestationsByNonceRange(t *testing.T) {
+	input, sdkCtx := setupAttestations(t)
+	k := input.BlobstreamKeeper
+
+	resp, err := k.AttestationsByNonceRange(
+		sdk.WrapSDKContext(sdkCtx),
+		&types.QueryAttestationsByNonceRangeRequest{BeginNonce: 3, EndNonce: 8, Pagination: &query.PageRequest{Limit: 3}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 4, 5}, attestationNonces(t, input, resp.Attestations))
+	require.NotNil(t, resp.Pagination.NextKey)
+
+	resp, err = k.AttestationsByNonceRange(
+		sdk.WrapSDKContext(sdkCtx),
+		&types.QueryAttestationsByNonceRangeRequest{BeginNonce: 3, EndNonce: 8, Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 3}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{6, 7}, attestationNonces(t, input, resp.Attestations))
+
+	// a zero end nonce means up to the latest attestation nonce
+	resp, err = k.AttestationsByNonceRange(
+		sdk.WrapSDKContext(sdkCtx),
+		&types.QueryAttestationsByNonceRangeRequest{BeginNonce: 9},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{9, 10, 11}, attestationNonces(t, input, resp.Attestations))
+	assert.Equal(t, uint64(3), resp.Pagination.Total)
+
+	_, err = k.AttestationsByNonceRange(
+		sdk.WrapSDKContext(sdkCtx),
+		&types.QueryAttestationsByNonceRangeRequest{BeginNonce: 8, EndNonce: 8},
+	)
+	assert.ErrorIs(t, err, types.ErrInvalidRange)
+}
+
+func TestAttestationsByHeightRange(t *testing.T) {
+	input, sdkCtx := setupAttestations(t)
+	k := input.BlobstreamKeeper
+
+	resp, err := k.AttestationsByHeightRange(
+		sdk.WrapSDKContext(sdkCtx),
+		&types.QueryAttestationsByHeightRangeRequest{BeginHeight: 1, EndHeight: 150},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3}, attestationNonces(t, input, resp.Attestations))
+
+	resp, err = k.AttestationsByHeightRange(
+		sdk.WrapSDKContext(sdkCtx),
+		&types.QueryAttestationsByHeightRangeRequest{BeginHeight: 101, EndHeight: 301},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 4}, attestationNonces(t, input, resp.Attestations))
+
+	_, err = k.AttestationsByHeightRange(
+		sdk.WrapSDKContext(sdkCtx),
+		&types.QueryAttestationsByHeightRangeRequest{BeginHeight: 10, EndHeight: 1},
+	)
+	assert.ErrorIs(t, err, types.ErrInvalidRange)
+}
+
+func TestAttestationNoncesForHeightRange(t *testing.T) {
+	input, sdkCtx := setupAttestations(t)
+	k := input.BlobstreamKeeper
+
+	tests := []struct {
+		name                         string
+		beginHeight, endHeight       uint64
+		wantBeginNonce, wantEndNonce uint64
+	}{
+		{"from the first height", 1, 150, 1, 4},
+		{"window boundaries", 101, 301, 2, 5},
+		{"inside a single window", 120, 130, 3, 4},
+		{"up to the latest height", 950, 1001, 11, 12},
+		{"after the latest height", 2000, 3000, 12, 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			begin, end, err := k.AttestationNoncesForHeightRange(sdkCtx, tt.beginHeight, tt.endHeight)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBeginNonce, begin)
+			assert.Equal(t, tt.wantEndNonce, end)
+		})
+	}
+}
+
+func TestDataCommitmentsForHeightRange(t *testing.T) {
+	input, sdkCtx := setupAttestations(t)
+	k := input.BlobstreamKeeper
+
+	resp, err := k.DataCommitmentsForHeightRange(
+		sdk.WrapSDKContext(sdkCtx),
+		&types.QueryDataCommitmentsForHeightRangeRequest{BeginHeight: 1, EndHeight: 250},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.DataCommitments, 3)
+	assert.Equal(t, uint64(2), resp.DataCommitments[0].Nonce)
+	assert.Equal(t, uint64(4), resp.DataCommitments[2].Nonce)
+
+	resp, err = k.DataCommitmentsForHeightRange(
+		sdk.WrapSDKContext(sdkCtx),
+		&types.QueryDataCommitmentsForHeightRangeRequest{BeginHeight: 2000, EndHeight: 3000},
+	)
+	require.NoError(t, err)
+	assert.Empty(t, resp.DataCommitments)
+}
+
+// mockAttestationsStream implements types.Query_AttestationsStreamServer and
+// forwards the sent attestations to a channel.
+type mockAttestationsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *types.QueryAttestationsStreamResponse
+}
+
+func (s mockAttestationsStream) Context() context.Context { return s.ctx }
+
+func (s mockAttestationsStream) Send(resp *types.QueryAttestationsStreamResponse) error {
+	s.sent <- resp
+	return nil
+}
+
+func TestAttestationsStream(t *testing.T) {
+	input, sdkCtx := setupAttestations(t)
+	k := input.BlobstreamKeeper
+	k.SetQueryContextProvider(func(int64, bool) (sdk.Context, error) { return sdkCtx, nil })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := mockAttestationsStream{ctx: ctx, sent: make(chan *types.QueryAttestationsStreamResponse, 20)}
+	done := make(chan error)
+	go func() {
+		done <- k.AttestationsStream(&types.QueryAttestationsStreamRequest{StartNonce: 9}, stream)
+	}()
+
+	receiveNonce := func() uint64 {
+		select {
+		case resp := <-stream.sent:
+			var at types.AttestationRequestI
+			require.NoError(t, input.Marshaler.UnpackAny(resp.Attestation, &at))
+			return at.GetNonce()
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an attestation")
+			return 0
+		}
+	}
+
+	// the already committed attestations
+	assert.Equal(t, uint64(9), receiveNonce())
+	assert.Equal(t, uint64(10), receiveNonce())
+	assert.Equal(t, uint64(11), receiveNonce())
+
+	// an attestation of a block that was not committed is never sent
+	require.NoError(t, k.SetAttestationRequest(sdkCtx.WithMultiStore(sdkCtx.MultiStore().CacheMultiStore()), types.NewDataCommitment(12, 1001, 1101, time.Now())))
+	k.DiscardUncommittedAttestations()
+	k.PublishCommittedAttestations()
+
+	// the newly created attestation is only sent once its block is committed
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, types.NewDataCommitment(12, 1001, 1101, time.Now())))
+	select {
+	case <-stream.sent:
+		t.Fatal("received an attestation before it was committed")
+	case <-time.After(100 * time.Millisecond):
+	}
+	k.PublishCommittedAttestations()
+	assert.Equal(t, uint64(12), receiveNonce())
+
+	cancel()
+	require.NoError(t, <-done)
+}
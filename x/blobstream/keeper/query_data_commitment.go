@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -30,3 +31,43 @@ func (k Keeper) LatestDataCommitment(
 		DataCommitment: &resp,
 	}, nil
 }
+
+func (k Keeper) DataCommitmentsForHeightRange(
+	c context.Context,
+	request *types.QueryDataCommitmentsForHeightRangeRequest,
+) (*types.QueryDataCommitmentsForHeightRangeResponse, error) {
+	if request.BeginHeight >= request.EndHeight {
+		return nil, errors.Wrapf(
+			types.ErrInvalidRange,
+			"begin height %d >= end height %d",
+			request.BeginHeight,
+			request.EndHeight,
+		)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(c)
+	beginNonce, endNonce, err := k.AttestationNoncesForHeightRange(sdkCtx, request.BeginHeight, request.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+	attestations, pageResponse, err := k.PaginateAttestations(
+		sdkCtx,
+		beginNonce,
+		endNonce,
+		request.Pagination,
+		func(at types.AttestationRequestI) bool {
+			dc, ok := at.(*types.DataCommitment)
+			return ok && dc.OverlapsHeightRange(request.BeginHeight, request.EndHeight)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	dataCommitments := make([]types.DataCommitment, 0, len(attestations))
+	for _, at := range attestations {
+		dataCommitments = append(dataCommitments, *at.(*types.DataCommitment))
+	}
+	return &types.QueryDataCommitmentsForHeightRangeResponse{
+		DataCommitments: dataCommitments,
+		Pagination:      pageResponse,
+	}, nil
+}
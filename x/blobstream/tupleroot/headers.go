@@ -0,0 +1,116 @@
+package tupleroot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// HeaderSource provides the headers of a range of blocks.
+type HeaderSource interface {
+	// Headers returns the headers of the blocks in [beginBlock, endBlock), in
+	// ascending height order.
+	Headers(ctx context.Context, beginBlock, endBlock uint64) ([]Header, error)
+}
+
+var (
+	_ HeaderSource = RPCSource{}
+	_ HeaderSource = FileSource{}
+)
+
+// RPCSource reads the headers from a Tendermint RPC endpoint.
+type RPCSource struct {
+	Client rpcclient.SignClient
+}
+
+func (s RPCSource) Headers(ctx context.Context, beginBlock, endBlock uint64) ([]Header, error) {
+	if beginBlock >= endBlock {
+		return nil, fmt.Errorf("invalid range [%d, %d)", beginBlock, endBlock)
+	}
+	headers := make([]Header, 0, endBlock-beginBlock)
+	for height := beginBlock; height < endBlock; height++ {
+		h := int64(height)
+		res, err := s.Client.Header(ctx, &h)
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, Header{Height: height, DataRoot: res.Header.DataHash})
+	}
+	return headers, nil
+}
+
+// FileSource serves the headers read from a file written by WriteHeadersFile.
+type FileSource struct {
+	headers map[uint64]Header
+}
+
+// ReadHeadersFile reads a JSON file of headers.
+func ReadHeadersFile(path string) (FileSource, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return FileSource{}, err
+	}
+	var headers []Header
+	if err := json.Unmarshal(bz, &headers); err != nil {
+		return FileSource{}, fmt.Errorf("decoding headers from %s: %w", path, err)
+	}
+	return NewFileSource(headers), nil
+}
+
+// NewFileSource returns a source serving the provided headers.
+func NewFileSource(headers []Header) FileSource {
+	s := FileSource{headers: make(map[uint64]Header, len(headers))}
+	for _, header := range headers {
+		s.headers[header.Height] = header
+	}
+	return s
+}
+
+func (s FileSource) Headers(_ context.Context, beginBlock, endBlock uint64) ([]Header, error) {
+	if beginBlock >= endBlock {
+		return nil, fmt.Errorf("invalid range [%d, %d)", beginBlock, endBlock)
+	}
+	headers := make([]Header, 0, endBlock-beginBlock)
+	for height := beginBlock; height < endBlock; height++ {
+		header, ok := s.headers[height]
+		if !ok {
+			return nil, fmt.Errorf("missing header at height %d", height)
+		}
+		headers = append(headers, header)
+	}
+	return headers, nil
+}
+
+// WriteHeadersFile writes the headers to a JSON file that can be read with
+// ReadHeadersFile.
+func WriteHeadersFile(path string, headers []Header) error {
+	bz, err := json.MarshalIndent(headers, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(bz, '\n'), 0o600)
+}
+
+// NewDataCommitmentTree builds the data root tuple tree of the data
+// commitment range from the headers of the source.
+func NewDataCommitmentTree(ctx context.Context, source HeaderSource, dataCommitment types.DataCommitment) (*Tree, error) {
+	if dataCommitment.BeginBlock == 0 || dataCommitment.BeginBlock >= dataCommitment.EndBlock {
+		return nil, fmt.Errorf("invalid data commitment range [%d, %d)", dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	}
+	headers, err := source.Headers(ctx, dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := NewTree(headers)
+	if err != nil {
+		return nil, err
+	}
+	if err := tree.CheckDataCommitment(dataCommitment); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
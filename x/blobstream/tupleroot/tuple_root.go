@@ -0,0 +1,152 @@
+// Package tupleroot computes the data root tuple roots committed to by the
+// Blobstream data commitments, and produces and verifies the inclusion proofs
+// of data root tuples to those roots, without relying on the node's
+// DataRootInclusionProof endpoint.
+//
+// A data root tuple is the ABI encoded (height, data root) pair of a block, as
+// defined by the DataRootTuple struct of the Blobstream contract. The data root
+// tuple root of a data commitment is the RFC 6962 binary merkle root of the
+// tuples of the blocks in [BeginBlock, EndBlock).
+package tupleroot
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/celestiaorg/go-square/merkle"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// Header is the part of a block header committed to by a data root tuple.
+type Header struct {
+	Height   uint64           `json:"height"`
+	DataRoot tmbytes.HexBytes `json:"data_root"`
+}
+
+// ValidateBasic checks that the header can be encoded as a data root tuple.
+func (h Header) ValidateBasic() error {
+	if h.Height == 0 {
+		return fmt.Errorf("invalid height 0")
+	}
+	if len(h.DataRoot) != 32 {
+		return fmt.Errorf("data root at height %d must be 32 bytes, got %d", h.Height, len(h.DataRoot))
+	}
+	return nil
+}
+
+// EncodeDataRootTuple ABI encodes the data root tuple of a block using the
+// DataRootTuple definition of the Blobstream contract.
+func EncodeDataRootTuple(height uint64, dataRoot []byte) ([]byte, error) {
+	if len(dataRoot) != 32 {
+		return nil, fmt.Errorf("data root must be 32 bytes, got %d", len(dataRoot))
+	}
+	return types.DataRootTupleAbi.Pack(wrapper.DataRootTuple{
+		Height:   new(big.Int).SetUint64(height),
+		DataRoot: *(*[32]byte)(dataRoot),
+	})
+}
+
+// Tree is the merkle tree of the data root tuples of a contiguous range of
+// blocks.
+type Tree struct {
+	beginBlock uint64
+	root       []byte
+	proofs     []*merkle.Proof
+}
+
+// NewTree builds the data root tuple tree of the provided headers. The headers
+// must be of contiguous heights, in ascending order.
+func NewTree(headers []Header) (*Tree, error) {
+	if len(headers) == 0 {
+		return nil, fmt.Errorf("cannot build a data root tuple tree without headers")
+	}
+	tuples := make([][]byte, len(headers))
+	for i, header := range headers {
+		if err := header.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		if expected := headers[0].Height + uint64(i); header.Height != expected {
+			return nil, fmt.Errorf("headers must be of contiguous ascending heights: expected height %d, got %d", expected, header.Height)
+		}
+		tuple, err := EncodeDataRootTuple(header.Height, header.DataRoot)
+		if err != nil {
+			return nil, err
+		}
+		tuples[i] = tuple
+	}
+	root, proofs := merkle.ProofsFromByteSlices(tuples)
+	return &Tree{
+		beginBlock: headers[0].Height,
+		root:       root,
+		proofs:     proofs,
+	}, nil
+}
+
+// BeginBlock returns the first height of the tree.
+func (t *Tree) BeginBlock() uint64 {
+	return t.beginBlock
+}
+
+// EndBlock returns the end exclusive height of the tree.
+func (t *Tree) EndBlock() uint64 {
+	return t.beginBlock + uint64(len(t.proofs))
+}
+
+// Root returns the data root tuple root.
+func (t *Tree) Root() []byte {
+	return t.root
+}
+
+// Prove returns the inclusion proof of the data root tuple of the provided
+// height to the root.
+func (t *Tree) Prove(height uint64) (merkle.Proof, error) {
+	if height < t.BeginBlock() || height >= t.EndBlock() {
+		return merkle.Proof{}, fmt.Errorf("height %d outside of the tree range [%d, %d)", height, t.BeginBlock(), t.EndBlock())
+	}
+	return *t.proofs[height-t.beginBlock], nil
+}
+
+// CheckDataCommitment checks that the tree covers the range of the data
+// commitment, so that its root is the one the data commitment commits to.
+func (t *Tree) CheckDataCommitment(dataCommitment types.DataCommitment) error {
+	if t.BeginBlock() != dataCommitment.BeginBlock || t.EndBlock() != dataCommitment.EndBlock {
+		return fmt.Errorf(
+			"tree range [%d, %d) doesn't match the range [%d, %d) of the data commitment with nonce %d",
+			t.BeginBlock(),
+			t.EndBlock(),
+			dataCommitment.BeginBlock,
+			dataCommitment.EndBlock,
+			dataCommitment.Nonce,
+		)
+	}
+	return nil
+}
+
+// VerifyInclusion verifies that the data root tuple of the provided height and
+// data root is included in the data root tuple root.
+func VerifyInclusion(root []byte, height uint64, dataRoot []byte, proof merkle.Proof) error {
+	tuple, err := EncodeDataRootTuple(height, dataRoot)
+	if err != nil {
+		return err
+	}
+	if err := proof.Verify(root, tuple); err != nil {
+		return fmt.Errorf("data root tuple of height %d not included in root %X: %w", height, root, err)
+	}
+	return nil
+}
+
+// VerifyRoot recomputes the data root tuple root of the headers and checks
+// that it equals the provided root.
+func VerifyRoot(root []byte, headers []Header) error {
+	tree, err := NewTree(headers)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(tree.Root(), root) {
+		return fmt.Errorf("data root tuple root mismatch: expected %X, got %X", root, tree.Root())
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package tupleroot_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/tupleroot"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/celestiaorg/go-square/merkle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/rpc/core"
+)
+
+func randomHeaders(beginBlock, endBlock uint64) []tupleroot.Header {
+	headers := make([]tupleroot.Header, 0, endBlock-beginBlock)
+	for height := beginBlock; height < endBlock; height++ {
+		headers = append(headers, tupleroot.Header{Height: height, DataRoot: tmrand.Bytes(32)})
+	}
+	return headers
+}
+
+func TestEncodeDataRootTuple(t *testing.T) {
+	dataRoot := tmrand.Bytes(32)
+	encoded, err := tupleroot.EncodeDataRootTuple(1234, dataRoot)
+	require.NoError(t, err)
+
+	// the ABI encoding matches the node's encoding
+	expected, err := core.EncodeDataRootTuple(1234, *(*[32]byte)(dataRoot))
+	require.NoError(t, err)
+	assert.Equal(t, expected, encoded)
+
+	_, err = tupleroot.EncodeDataRootTuple(1234, dataRoot[:31])
+	assert.Error(t, err)
+}
+
+func TestTree(t *testing.T) {
+	headers := randomHeaders(10, 17)
+	tree, err := tupleroot.NewTree(headers)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), tree.BeginBlock())
+	assert.Equal(t, uint64(17), tree.EndBlock())
+
+	tuples := make([][]byte, len(headers))
+	for i, header := range headers {
+		tuples[i], err = core.EncodeDataRootTuple(header.Height, *(*[32]byte)(header.DataRoot))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, merkle.HashFromByteSlices(tuples), tree.Root())
+	require.NoError(t, tupleroot.VerifyRoot(tree.Root(), headers))
+
+	for _, header := range headers {
+		proof, err := tree.Prove(header.Height)
+		require.NoError(t, err)
+		assert.NoError(t, tupleroot.VerifyInclusion(tree.Root(), header.Height, header.DataRoot, proof))
+		assert.Error(t, tupleroot.VerifyInclusion(tree.Root(), header.Height, tmrand.Bytes(32), proof))
+		assert.Error(t, tupleroot.VerifyInclusion(tree.Root(), header.Height+1, header.DataRoot, proof))
+	}
+
+	_, err = tree.Prove(17)
+	assert.Error(t, err)
+
+	assert.NoError(t, tree.CheckDataCommitment(*types.NewDataCommitment(1, 10, 17, time.Now())))
+	assert.Error(t, tree.CheckDataCommitment(*types.NewDataCommitment(1, 10, 18, time.Now())))
+}
+
+func TestNewTreeInvalidHeaders(t *testing.T) {
+	_, err := tupleroot.NewTree(nil)
+	assert.Error(t, err)
+
+	headers := randomHeaders(1, 5)
+	headers[2].Height = 10
+	_, err = tupleroot.NewTree(headers)
+	assert.Error(t, err)
+
+	headers = randomHeaders(1, 5)
+	headers[1].DataRoot = headers[1].DataRoot[:16]
+	_, err = tupleroot.NewTree(headers)
+	assert.Error(t, err)
+}
+
+func TestFileSource(t *testing.T) {
+	headers := randomHeaders(1, 101)
+	path := filepath.Join(t.TempDir(), "headers.json")
+	require.NoError(t, tupleroot.WriteHeadersFile(path, headers))
+	source, err := tupleroot.ReadHeadersFile(path)
+	require.NoError(t, err)
+
+	tree, err := tupleroot.NewDataCommitmentTree(context.Background(), source, *types.NewDataCommitment(1, 1, 101, time.Now()))
+	require.NoError(t, err)
+	require.NoError(t, tupleroot.VerifyRoot(tree.Root(), headers))
+
+	subTree, err := tupleroot.NewDataCommitmentTree(context.Background(), source, *types.NewDataCommitment(2, 50, 60, time.Now()))
+	require.NoError(t, err)
+	require.NoError(t, tupleroot.VerifyRoot(subTree.Root(), headers[49:59]))
+
+	_, err = tupleroot.NewDataCommitmentTree(context.Background(), source, *types.NewDataCommitment(3, 50, 200, time.Now()))
+	assert.Error(t, err)
+}
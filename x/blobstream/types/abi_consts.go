@@ -119,6 +119,7 @@ var (
 	ExternalBlobstreamABI abi.ABI
 	InternalBlobstreamABI abi.ABI
 	BridgeValidatorAbi    abi.Arguments
+	DataRootTupleAbi      abi.Arguments
 
 	VsDomainSeparator ethcmn.Hash
 	DcDomainSeparator ethcmn.Hash
@@ -149,6 +150,20 @@ func init() {
 		{Type: solValidatorType, Name: "Validator"},
 	}
 
+	// the data root tuple as defined in the DataRootTuple struct of the
+	// Blobstream contract
+	solDataRootTupleType, err := abi.NewType("tuple", "dataRootTuple", []abi.ArgumentMarshaling{
+		{Name: "Height", Type: "uint256"},
+		{Name: "DataRoot", Type: "bytes32"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	DataRootTupleAbi = abi.Arguments{
+		{Type: solDataRootTupleType, Name: "DataRootTuple"},
+	}
+
 	// create the domain separator for valset hashes
 	VsDomainSeparator = ethcmn.HexToHash(ValidatorSetDomainSeparator)
 	DcDomainSeparator = ethcmn.HexToHash(DataCommitmentDomainSeparator)
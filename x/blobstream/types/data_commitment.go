@@ -22,3 +22,9 @@ func NewDataCommitment(
 func (m *DataCommitment) BlockTime() time.Time {
 	return m.Time
 }
+
+// OverlapsHeightRange returns true if the data commitment commits to at least
+// one block in the [beginHeight, endHeight) range.
+func (m *DataCommitment) OverlapsHeightRange(beginHeight uint64, endHeight uint64) bool {
+	return m.BeginBlock < endHeight && m.EndBlock > beginHeight
+}
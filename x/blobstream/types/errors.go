@@ -27,4 +27,8 @@ var (
 	ErrEVMAddressNotHex                          = errors.Register(ModuleName, 36, "the provided evm address is not a valid hex address")
 	ErrEVMAddressAlreadyExists                   = errors.Register(ModuleName, 37, "the provided evm address already exists")
 	ErrEVMAddressNotFound                        = errors.Register(ModuleName, 38, "EVM address not found")
+	ErrInvalidRange                              = errors.Register(ModuleName, 39, "invalid range")
+	ErrAttestationsStreamUnavailable             = errors.Register(ModuleName, 40, "the attestations stream is not available")
+	ErrInvalidAttestationExpiryTime              = errors.Register(ModuleName, 41, "invalid attestation expiry time")
+	ErrInvalidPowerDifferenceThreshold           = errors.Register(ModuleName, 42, "invalid significant power difference threshold")
 )
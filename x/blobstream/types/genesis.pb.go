@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +32,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params represent Blobstream genesis and store parameters.
 type Params struct {
 	DataCommitmentWindow uint64 `protobuf:"varint,1,opt,name=data_commitment_window,json=dataCommitmentWindow,proto3" json:"data_commitment_window,omitempty"`
+	// AttestationExpiryTime is the time after which an attestation is pruned
+	// from state.
+	AttestationExpiryTime time.Duration `protobuf:"bytes,2,opt,name=attestation_expiry_time,json=attestationExpiryTime,proto3,stdduration" json:"attestation_expiry_time"`
+	// SignificantPowerDifferenceThreshold is the threshold of change in the
+	// validator set power that triggers the creation of a new valset request.
+	SignificantPowerDifferenceThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=significant_power_difference_threshold,json=significantPowerDifferenceThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"significant_power_difference_threshold"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,6 +80,13 @@ func (m *Params) GetDataCommitmentWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetAttestationExpiryTime() time.Duration {
+	if m != nil {
+		return m.AttestationExpiryTime
+	}
+	return 0
+}
+
 // GenesisState struct, containing all persistent data required by Blobstream
 // module
 type GenesisState struct {
@@ -122,23 +141,35 @@ func init() {
 func init() { proto.RegisterFile("celestia/qgb/v1/genesis.proto", fileDescriptor_10da5f8e88ce2856) }
 
 var fileDescriptor_10da5f8e88ce2856 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x2f, 0x4c, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x49, 0xeb, 0x15, 0xa6,
-	0x27, 0xe9, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xe5, 0xf4, 0x41, 0x2c, 0x88,
-	0x32, 0x29, 0x69, 0x74, 0x53, 0x4a, 0x2a, 0x0b, 0x52, 0xa1, 0x66, 0x28, 0xb9, 0x70, 0xb1, 0x05,
-	0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x99, 0x70, 0x89, 0xa5, 0x24, 0x96, 0x24, 0xc6, 0x27, 0xe7,
-	0xe7, 0xe6, 0x66, 0x96, 0xe4, 0xa6, 0xe6, 0x95, 0xc4, 0x97, 0x67, 0xe6, 0xa5, 0xe4, 0x97, 0x4b,
-	0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x04, 0x89, 0x80, 0x64, 0x9d, 0xe1, 0x92, 0xe1, 0x60, 0x39, 0x2b,
-	0x96, 0x86, 0x3b, 0x0a, 0x0c, 0x4a, 0xf6, 0x5c, 0x3c, 0xee, 0x10, 0xa7, 0x05, 0x97, 0x24, 0x96,
-	0xa4, 0x0a, 0xe9, 0x73, 0xb1, 0x15, 0x80, 0x4d, 0x05, 0xeb, 0xe5, 0x36, 0x12, 0xd7, 0x43, 0x73,
-	0xaa, 0x1e, 0xc4, 0xd2, 0x20, 0xa8, 0x32, 0xa7, 0x80, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0x32, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87,
-	0x19, 0x92, 0x5f, 0x94, 0x0e, 0x67, 0xeb, 0x26, 0x16, 0x14, 0xe8, 0x57, 0xe8, 0x27, 0xe5, 0xe4,
-	0x27, 0x15, 0x97, 0x14, 0xa5, 0x26, 0xe6, 0x42, 0xbc, 0x97, 0xc4, 0x06, 0xf6, 0x9f, 0x31, 0x20,
-	0x00, 0x00, 0xff, 0xff, 0x11, 0x19, 0x03, 0x69, 0x44, 0x01, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x41, 0x6b, 0x13, 0x41,
+	0x18, 0x86, 0x77, 0x6a, 0x09, 0xba, 0x15, 0x84, 0xa5, 0xda, 0xb4, 0xe2, 0x26, 0x54, 0x28, 0xb9,
+	0x64, 0x86, 0x56, 0xf1, 0x20, 0x82, 0x10, 0x23, 0x5e, 0x43, 0x2c, 0x08, 0x7a, 0x58, 0x66, 0x77,
+	0xbf, 0x4c, 0x06, 0x33, 0x3b, 0xdb, 0x99, 0x2f, 0x4d, 0x7b, 0xf3, 0x27, 0xe8, 0xcd, 0x1f, 0xa2,
+	0xff, 0xa1, 0xc7, 0xe2, 0x49, 0x44, 0xaa, 0x24, 0x7f, 0xa4, 0xec, 0xec, 0x24, 0x84, 0x9c, 0x76,
+	0x86, 0xe7, 0xdb, 0xf7, 0x7d, 0xe7, 0xfd, 0xc2, 0x27, 0x19, 0x4c, 0xc0, 0xa2, 0xe4, 0xec, 0x4c,
+	0xa4, 0xec, 0xfc, 0x98, 0x09, 0x28, 0xc0, 0x4a, 0x4b, 0x4b, 0xa3, 0x51, 0x47, 0x0f, 0x96, 0x98,
+	0x9e, 0x89, 0x94, 0x9e, 0x1f, 0x1f, 0xec, 0x0a, 0x2d, 0xb4, 0x63, 0xac, 0x3a, 0xd5, 0x63, 0x07,
+	0xfb, 0x99, 0xb6, 0x4a, 0xdb, 0xa4, 0x06, 0xf5, 0xc5, 0xa3, 0x58, 0x68, 0x2d, 0x26, 0xc0, 0xdc,
+	0x2d, 0x9d, 0x8e, 0x58, 0x3e, 0x35, 0x1c, 0xa5, 0x2e, 0x3c, 0x7f, 0xbc, 0x19, 0x00, 0x2f, 0x4b,
+	0xf0, 0x3f, 0x1f, 0xfe, 0xdc, 0x0a, 0x1b, 0x03, 0x6e, 0xb8, 0xb2, 0xd1, 0xf3, 0xf0, 0x51, 0xce,
+	0x91, 0x27, 0x99, 0x56, 0x4a, 0xa2, 0x82, 0x02, 0x93, 0x99, 0x2c, 0x72, 0x3d, 0x6b, 0x92, 0x36,
+	0xe9, 0x6c, 0x0f, 0x77, 0x2b, 0xfa, 0x66, 0x05, 0x3f, 0x38, 0x16, 0x7d, 0x0a, 0xf7, 0x38, 0x22,
+	0x58, 0x74, 0x96, 0x09, 0x5c, 0x94, 0xd2, 0x5c, 0x26, 0x28, 0x15, 0x34, 0xb7, 0xda, 0xa4, 0xb3,
+	0x73, 0xb2, 0x4f, 0xeb, 0x7c, 0x74, 0x99, 0x8f, 0xf6, 0x7d, 0xbe, 0xde, 0xdd, 0xab, 0x9b, 0x56,
+	0xf0, 0xfd, 0x5f, 0x8b, 0x0c, 0x1f, 0xae, 0x69, 0xbc, 0x75, 0x12, 0xa7, 0x52, 0x41, 0xf4, 0x8d,
+	0x84, 0x47, 0x56, 0x8a, 0x42, 0x8e, 0x64, 0xc6, 0x0b, 0x4c, 0x4a, 0x3d, 0x03, 0x93, 0xe4, 0x72,
+	0x34, 0x02, 0x03, 0x45, 0x06, 0x09, 0x8e, 0x0d, 0xd8, 0xb1, 0x9e, 0xe4, 0xcd, 0x3b, 0x6d, 0xd2,
+	0xb9, 0xd7, 0x7b, 0x55, 0x29, 0xfe, 0xb9, 0x69, 0x1d, 0x09, 0x89, 0xe3, 0x69, 0x4a, 0x33, 0xad,
+	0x7c, 0x59, 0xfe, 0xd3, 0xb5, 0xf9, 0x67, 0x5f, 0x40, 0x1f, 0xb2, 0x5f, 0x3f, 0xba, 0xa1, 0xef,
+	0xb2, 0x0f, 0xd9, 0xf0, 0xe9, 0x9a, 0xd7, 0xa0, 0xb2, 0xea, 0xaf, 0x9c, 0x4e, 0x97, 0x46, 0x2f,
+	0xb7, 0xbf, 0xfc, 0x6d, 0x07, 0x87, 0xaf, 0xc3, 0xfb, 0xef, 0xea, 0x3d, 0xbe, 0x47, 0x8e, 0x10,
+	0xb1, 0xb0, 0x51, 0xba, 0x1a, 0x5d, 0x59, 0x3b, 0x27, 0x7b, 0x74, 0x63, 0xaf, 0xb4, 0x6e, 0x79,
+	0xe8, 0xc7, 0x7a, 0x83, 0xab, 0x79, 0x4c, 0xae, 0xe7, 0x31, 0xf9, 0x3f, 0x8f, 0xc9, 0xd7, 0x45,
+	0x1c, 0x5c, 0x2f, 0xe2, 0xe0, 0xf7, 0x22, 0x0e, 0x3e, 0xbe, 0x58, 0xcf, 0xee, 0x45, 0xb4, 0x11,
+	0xab, 0x73, 0x97, 0x97, 0x25, 0xbb, 0x60, 0xe9, 0x44, 0xa7, 0x16, 0x0d, 0x70, 0x55, 0xbf, 0x27,
+	0x6d, 0xb8, 0x82, 0x9f, 0xdd, 0x06, 0x00, 0x00, 0xff, 0xff, 0x17, 0x54, 0x49, 0x92, 0x71, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -161,6 +192,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SignificantPowerDifferenceThreshold.Size()
+		i -= size
+		if _, err := m.SignificantPowerDifferenceThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AttestationExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AttestationExpiryTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
 	if m.DataCommitmentWindow != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.DataCommitmentWindow))
 		i--
@@ -224,6 +273,10 @@ func (m *Params) Size() (n int) {
 	if m.DataCommitmentWindow != 0 {
 		n += 1 + sovGenesis(uint64(m.DataCommitmentWindow))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AttestationExpiryTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SignificantPowerDifferenceThreshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -294,6 +347,73 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AttestationExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignificantPowerDifferenceThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignificantPowerDifferenceThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
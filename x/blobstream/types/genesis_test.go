@@ -6,6 +6,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
 
 	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/stretchr/testify/require"
 )
@@ -44,7 +45,9 @@ func TestGenesisStateValidate(t *testing.T) {
 		"valid params: data commitments blocks limit": {
 			src: &types.GenesisState{
 				Params: &types.Params{
-					DataCommitmentWindow: uint64(appconsts.DataCommitmentBlocksLimit),
+					DataCommitmentWindow:                uint64(appconsts.DataCommitmentBlocksLimit),
+					AttestationExpiryTime:               types.DefaultAttestationExpiryTime,
+					SignificantPowerDifferenceThreshold: types.DefaultSignificantPowerDifferenceThreshold,
 				},
 			},
 			expErr: false,
@@ -52,11 +55,41 @@ func TestGenesisStateValidate(t *testing.T) {
 		"valid params: minimum data commitment window": {
 			src: &types.GenesisState{
 				Params: &types.Params{
-					DataCommitmentWindow: types.MinimumDataCommitmentWindow,
+					DataCommitmentWindow:                types.MinimumDataCommitmentWindow,
+					AttestationExpiryTime:               types.DefaultAttestationExpiryTime,
+					SignificantPowerDifferenceThreshold: types.DefaultSignificantPowerDifferenceThreshold,
 				},
 			},
 			expErr: false,
 		},
+		"invalid params: zero attestation expiry time": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:                types.DefaultDataCommitmentWindow,
+					SignificantPowerDifferenceThreshold: types.DefaultSignificantPowerDifferenceThreshold,
+				},
+			},
+			expErr: true,
+		},
+		"invalid params: nil significant power difference threshold": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:  types.DefaultDataCommitmentWindow,
+					AttestationExpiryTime: types.DefaultAttestationExpiryTime,
+				},
+			},
+			expErr: true,
+		},
+		"invalid params: significant power difference threshold above one": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:                types.DefaultDataCommitmentWindow,
+					AttestationExpiryTime:               types.DefaultAttestationExpiryTime,
+					SignificantPowerDifferenceThreshold: sdk.NewDecWithPrec(11, 1),
+				},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -208,6 +209,336 @@ func (m *QueryAttestationRequestByNonceResponse) GetAttestation() *types.Any {
 	return nil
 }
 
+// QueryAttestationsByNonceRangeRequest
+type QueryAttestationsByNonceRangeRequest struct {
+	// First nonce of the range.
+	BeginNonce uint64 `protobuf:"varint,1,opt,name=begin_nonce,json=beginNonce,proto3" json:"begin_nonce,omitempty"`
+	// End exclusive last nonce of the range. Zero means up to the latest
+	// attestation nonce.
+	EndNonce   uint64             `protobuf:"varint,2,opt,name=end_nonce,json=endNonce,proto3" json:"end_nonce,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttestationsByNonceRangeRequest) Reset()         { *m = QueryAttestationsByNonceRangeRequest{} }
+func (m *QueryAttestationsByNonceRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsByNonceRangeRequest) ProtoMessage()    {}
+func (*QueryAttestationsByNonceRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{4}
+}
+func (m *QueryAttestationsByNonceRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsByNonceRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsByNonceRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsByNonceRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsByNonceRangeRequest.Merge(m, src)
+}
+func (m *QueryAttestationsByNonceRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsByNonceRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsByNonceRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsByNonceRangeRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationsByNonceRangeRequest) GetBeginNonce() uint64 {
+	if m != nil {
+		return m.BeginNonce
+	}
+	return 0
+}
+
+func (m *QueryAttestationsByNonceRangeRequest) GetEndNonce() uint64 {
+	if m != nil {
+		return m.EndNonce
+	}
+	return 0
+}
+
+func (m *QueryAttestationsByNonceRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttestationsByNonceRangeResponse
+type QueryAttestationsByNonceRangeResponse struct {
+	// Each attestation is either a Data Commitment or a Valset.
+	Attestations []*types.Any        `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttestationsByNonceRangeResponse) Reset()         { *m = QueryAttestationsByNonceRangeResponse{} }
+func (m *QueryAttestationsByNonceRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsByNonceRangeResponse) ProtoMessage()    {}
+func (*QueryAttestationsByNonceRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{5}
+}
+func (m *QueryAttestationsByNonceRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsByNonceRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsByNonceRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsByNonceRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsByNonceRangeResponse.Merge(m, src)
+}
+func (m *QueryAttestationsByNonceRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsByNonceRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsByNonceRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsByNonceRangeResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationsByNonceRangeResponse) GetAttestations() []*types.Any {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *QueryAttestationsByNonceRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttestationsByHeightRangeRequest
+type QueryAttestationsByHeightRangeRequest struct {
+	// First height of the range.
+	BeginHeight uint64 `protobuf:"varint,1,opt,name=begin_height,json=beginHeight,proto3" json:"begin_height,omitempty"`
+	// End exclusive last height of the range.
+	EndHeight  uint64             `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttestationsByHeightRangeRequest) Reset()         { *m = QueryAttestationsByHeightRangeRequest{} }
+func (m *QueryAttestationsByHeightRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsByHeightRangeRequest) ProtoMessage()    {}
+func (*QueryAttestationsByHeightRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{6}
+}
+func (m *QueryAttestationsByHeightRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsByHeightRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsByHeightRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsByHeightRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsByHeightRangeRequest.Merge(m, src)
+}
+func (m *QueryAttestationsByHeightRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsByHeightRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsByHeightRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsByHeightRangeRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationsByHeightRangeRequest) GetBeginHeight() uint64 {
+	if m != nil {
+		return m.BeginHeight
+	}
+	return 0
+}
+
+func (m *QueryAttestationsByHeightRangeRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryAttestationsByHeightRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttestationsByHeightRangeResponse
+type QueryAttestationsByHeightRangeResponse struct {
+	// Each attestation is either a Data Commitment or a Valset.
+	Attestations []*types.Any        `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttestationsByHeightRangeResponse) Reset() {
+	*m = QueryAttestationsByHeightRangeResponse{}
+}
+func (m *QueryAttestationsByHeightRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsByHeightRangeResponse) ProtoMessage()    {}
+func (*QueryAttestationsByHeightRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{7}
+}
+func (m *QueryAttestationsByHeightRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsByHeightRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsByHeightRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsByHeightRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsByHeightRangeResponse.Merge(m, src)
+}
+func (m *QueryAttestationsByHeightRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsByHeightRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsByHeightRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsByHeightRangeResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationsByHeightRangeResponse) GetAttestations() []*types.Any {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *QueryAttestationsByHeightRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttestationsStreamRequest
+type QueryAttestationsStreamRequest struct {
+	// Nonce of the first attestation to stream. Zero means the attestations
+	// created after the stream is opened.
+	StartNonce uint64 `protobuf:"varint,1,opt,name=start_nonce,json=startNonce,proto3" json:"start_nonce,omitempty"`
+}
+
+func (m *QueryAttestationsStreamRequest) Reset()         { *m = QueryAttestationsStreamRequest{} }
+func (m *QueryAttestationsStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsStreamRequest) ProtoMessage()    {}
+func (*QueryAttestationsStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{8}
+}
+func (m *QueryAttestationsStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsStreamRequest.Merge(m, src)
+}
+func (m *QueryAttestationsStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsStreamRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationsStreamRequest) GetStartNonce() uint64 {
+	if m != nil {
+		return m.StartNonce
+	}
+	return 0
+}
+
+// QueryAttestationsStreamResponse
+type QueryAttestationsStreamResponse struct {
+	// AttestationRequestI is either a Data Commitment or a Valset.
+	Attestation *types.Any `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+}
+
+func (m *QueryAttestationsStreamResponse) Reset()         { *m = QueryAttestationsStreamResponse{} }
+func (m *QueryAttestationsStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsStreamResponse) ProtoMessage()    {}
+func (*QueryAttestationsStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{9}
+}
+func (m *QueryAttestationsStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsStreamResponse.Merge(m, src)
+}
+func (m *QueryAttestationsStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsStreamResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationsStreamResponse) GetAttestation() *types.Any {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
 // QueryLatestAttestationNonceRequest latest attestation nonce request
 type QueryLatestAttestationNonceRequest struct {
 }
@@ -216,7 +547,7 @@ func (m *QueryLatestAttestationNonceRequest) Reset()         { *m = QueryLatestA
 func (m *QueryLatestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestAttestationNonceRequest) ProtoMessage()    {}
 func (*QueryLatestAttestationNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{4}
+	return fileDescriptor_c8535c57355a2b91, []int{10}
 }
 func (m *QueryLatestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +585,7 @@ func (m *QueryLatestAttestationNonceResponse) Reset()         { *m = QueryLatest
 func (m *QueryLatestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestAttestationNonceResponse) ProtoMessage()    {}
 func (*QueryLatestAttestationNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{5}
+	return fileDescriptor_c8535c57355a2b91, []int{11}
 }
 func (m *QueryLatestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +629,7 @@ func (m *QueryEarliestAttestationNonceRequest) Reset()         { *m = QueryEarli
 func (m *QueryEarliestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEarliestAttestationNonceRequest) ProtoMessage()    {}
 func (*QueryEarliestAttestationNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{6}
+	return fileDescriptor_c8535c57355a2b91, []int{12}
 }
 func (m *QueryEarliestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +667,7 @@ func (m *QueryEarliestAttestationNonceResponse) Reset()         { *m = QueryEarl
 func (m *QueryEarliestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEarliestAttestationNonceResponse) ProtoMessage()    {}
 func (*QueryEarliestAttestationNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{7}
+	return fileDescriptor_c8535c57355a2b91, []int{13}
 }
 func (m *QueryEarliestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +717,7 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) String() string {
 }
 func (*QueryLatestValsetRequestBeforeNonceRequest) ProtoMessage() {}
 func (*QueryLatestValsetRequestBeforeNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{8}
+	return fileDescriptor_c8535c57355a2b91, []int{14}
 }
 func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +767,7 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) String() string {
 }
 func (*QueryLatestValsetRequestBeforeNonceResponse) ProtoMessage() {}
 func (*QueryLatestValsetRequestBeforeNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{9}
+	return fileDescriptor_c8535c57355a2b91, []int{15}
 }
 func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +811,7 @@ func (m *QueryLatestUnbondingHeightRequest) Reset()         { *m = QueryLatestUn
 func (m *QueryLatestUnbondingHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestUnbondingHeightRequest) ProtoMessage()    {}
 func (*QueryLatestUnbondingHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{10}
+	return fileDescriptor_c8535c57355a2b91, []int{16}
 }
 func (m *QueryLatestUnbondingHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +849,7 @@ func (m *QueryLatestUnbondingHeightResponse) Reset()         { *m = QueryLatestU
 func (m *QueryLatestUnbondingHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestUnbondingHeightResponse) ProtoMessage()    {}
 func (*QueryLatestUnbondingHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{11}
+	return fileDescriptor_c8535c57355a2b91, []int{17}
 }
 func (m *QueryLatestUnbondingHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +893,7 @@ func (m *QueryLatestDataCommitmentRequest) Reset()         { *m = QueryLatestDat
 func (m *QueryLatestDataCommitmentRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestDataCommitmentRequest) ProtoMessage()    {}
 func (*QueryLatestDataCommitmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{12}
+	return fileDescriptor_c8535c57355a2b91, []int{18}
 }
 func (m *QueryLatestDataCommitmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +931,7 @@ func (m *QueryLatestDataCommitmentResponse) Reset()         { *m = QueryLatestDa
 func (m *QueryLatestDataCommitmentResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestDataCommitmentResponse) ProtoMessage()    {}
 func (*QueryLatestDataCommitmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{13}
+	return fileDescriptor_c8535c57355a2b91, []int{19}
 }
 func (m *QueryLatestDataCommitmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,7 +978,7 @@ func (m *QueryDataCommitmentRangeForHeightRequest) Reset() {
 func (m *QueryDataCommitmentRangeForHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDataCommitmentRangeForHeightRequest) ProtoMessage()    {}
 func (*QueryDataCommitmentRangeForHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{14}
+	return fileDescriptor_c8535c57355a2b91, []int{20}
 }
 func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +1027,7 @@ func (m *QueryDataCommitmentRangeForHeightResponse) String() string {
 }
 func (*QueryDataCommitmentRangeForHeightResponse) ProtoMessage() {}
 func (*QueryDataCommitmentRangeForHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{15}
+	return fileDescriptor_c8535c57355a2b91, []int{21}
 }
 func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,23 +1063,31 @@ func (m *QueryDataCommitmentRangeForHeightResponse) GetDataCommitment() *DataCom
 	return nil
 }
 
-// QueryEVMAddressRequest
-type QueryEVMAddressRequest struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+// QueryDataCommitmentsForHeightRangeRequest
+type QueryDataCommitmentsForHeightRangeRequest struct {
+	// First height of the range.
+	BeginHeight uint64 `protobuf:"varint,1,opt,name=begin_height,json=beginHeight,proto3" json:"begin_height,omitempty"`
+	// End exclusive last height of the range.
+	EndHeight  uint64             `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEVMAddressRequest) Reset()         { *m = QueryEVMAddressRequest{} }
-func (m *QueryEVMAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEVMAddressRequest) ProtoMessage()    {}
-func (*QueryEVMAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{16}
+func (m *QueryDataCommitmentsForHeightRangeRequest) Reset() {
+	*m = QueryDataCommitmentsForHeightRangeRequest{}
 }
-func (m *QueryEVMAddressRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDataCommitmentsForHeightRangeRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDataCommitmentsForHeightRangeRequest) ProtoMessage() {}
+func (*QueryDataCommitmentsForHeightRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{22}
+}
+func (m *QueryDataCommitmentsForHeightRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDataCommitmentsForHeightRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEVMAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDataCommitmentsForHeightRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -758,38 +1097,154 @@ func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryEVMAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEVMAddressRequest.Merge(m, src)
+func (m *QueryDataCommitmentsForHeightRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentsForHeightRangeRequest.Merge(m, src)
 }
-func (m *QueryEVMAddressRequest) XXX_Size() int {
+func (m *QueryDataCommitmentsForHeightRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEVMAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEVMAddressRequest.DiscardUnknown(m)
+func (m *QueryDataCommitmentsForHeightRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentsForHeightRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEVMAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDataCommitmentsForHeightRangeRequest proto.InternalMessageInfo
 
-func (m *QueryEVMAddressRequest) GetValidatorAddress() string {
+func (m *QueryDataCommitmentsForHeightRangeRequest) GetBeginHeight() uint64 {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.BeginHeight
 	}
-	return ""
+	return 0
 }
 
-// QueryEVMAddressResponse
-type QueryEVMAddressResponse struct {
-	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+func (m *QueryDataCommitmentsForHeightRangeRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-func (m *QueryEVMAddressResponse) Reset()         { *m = QueryEVMAddressResponse{} }
-func (m *QueryEVMAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEVMAddressResponse) ProtoMessage()    {}
-func (*QueryEVMAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{17}
+func (m *QueryDataCommitmentsForHeightRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryEVMAddressResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+// QueryDataCommitmentsForHeightRangeResponse
+type QueryDataCommitmentsForHeightRangeResponse struct {
+	DataCommitments []DataCommitment    `protobuf:"bytes,1,rep,name=data_commitments,json=dataCommitments,proto3" json:"data_commitments"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDataCommitmentsForHeightRangeResponse) Reset() {
+	*m = QueryDataCommitmentsForHeightRangeResponse{}
+}
+func (m *QueryDataCommitmentsForHeightRangeResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDataCommitmentsForHeightRangeResponse) ProtoMessage() {}
+func (*QueryDataCommitmentsForHeightRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{23}
+}
+func (m *QueryDataCommitmentsForHeightRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataCommitmentsForHeightRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataCommitmentsForHeightRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataCommitmentsForHeightRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentsForHeightRangeResponse.Merge(m, src)
+}
+func (m *QueryDataCommitmentsForHeightRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataCommitmentsForHeightRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentsForHeightRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataCommitmentsForHeightRangeResponse proto.InternalMessageInfo
+
+func (m *QueryDataCommitmentsForHeightRangeResponse) GetDataCommitments() []DataCommitment {
+	if m != nil {
+		return m.DataCommitments
+	}
+	return nil
+}
+
+func (m *QueryDataCommitmentsForHeightRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEVMAddressRequest
+type QueryEVMAddressRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryEVMAddressRequest) Reset()         { *m = QueryEVMAddressRequest{} }
+func (m *QueryEVMAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressRequest) ProtoMessage()    {}
+func (*QueryEVMAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{24}
+}
+func (m *QueryEVMAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEVMAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressRequest.Merge(m, src)
+}
+func (m *QueryEVMAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEVMAddressRequest proto.InternalMessageInfo
+
+func (m *QueryEVMAddressRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryEVMAddressResponse
+type QueryEVMAddressResponse struct {
+	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+}
+
+func (m *QueryEVMAddressResponse) Reset()         { *m = QueryEVMAddressResponse{} }
+func (m *QueryEVMAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressResponse) ProtoMessage()    {}
+func (*QueryEVMAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{25}
+}
+func (m *QueryEVMAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
@@ -827,6 +1282,12 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.qgb.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceRequest")
 	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceResponse")
+	proto.RegisterType((*QueryAttestationsByNonceRangeRequest)(nil), "celestia.qgb.v1.QueryAttestationsByNonceRangeRequest")
+	proto.RegisterType((*QueryAttestationsByNonceRangeResponse)(nil), "celestia.qgb.v1.QueryAttestationsByNonceRangeResponse")
+	proto.RegisterType((*QueryAttestationsByHeightRangeRequest)(nil), "celestia.qgb.v1.QueryAttestationsByHeightRangeRequest")
+	proto.RegisterType((*QueryAttestationsByHeightRangeResponse)(nil), "celestia.qgb.v1.QueryAttestationsByHeightRangeResponse")
+	proto.RegisterType((*QueryAttestationsStreamRequest)(nil), "celestia.qgb.v1.QueryAttestationsStreamRequest")
+	proto.RegisterType((*QueryAttestationsStreamResponse)(nil), "celestia.qgb.v1.QueryAttestationsStreamResponse")
 	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceRequest")
 	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceResponse")
 	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceRequest")
@@ -839,6 +1300,8 @@ func init() {
 	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentResponse")
 	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightRequest")
 	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightResponse")
+	proto.RegisterType((*QueryDataCommitmentsForHeightRangeRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentsForHeightRangeRequest")
+	proto.RegisterType((*QueryDataCommitmentsForHeightRangeResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentsForHeightRangeResponse")
 	proto.RegisterType((*QueryEVMAddressRequest)(nil), "celestia.qgb.v1.QueryEVMAddressRequest")
 	proto.RegisterType((*QueryEVMAddressResponse)(nil), "celestia.qgb.v1.QueryEVMAddressResponse")
 }
@@ -846,64 +1309,86 @@ func init() {
 func init() { proto.RegisterFile("celestia/qgb/v1/query.proto", fileDescriptor_c8535c57355a2b91) }
 
 var fileDescriptor_c8535c57355a2b91 = []byte{
-	// 910 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0x63, 0xd4, 0x46, 0xe2, 0x45, 0xea, 0x8f, 0x49, 0x9a, 0xed, 0xba, 0xc5, 0xbb, 0x75,
-	0xb2, 0xe9, 0x96, 0x65, 0x3d, 0xda, 0x2c, 0x5d, 0x44, 0x5b, 0x0e, 0x1b, 0x28, 0x2a, 0x52, 0x81,
-	0x12, 0x89, 0x1e, 0x38, 0xb0, 0x1a, 0x27, 0x53, 0xaf, 0x45, 0xec, 0x49, 0x6c, 0x27, 0x22, 0x02,
-	0x2e, 0xfc, 0x05, 0x48, 0x1c, 0x39, 0x73, 0xe5, 0x84, 0xb8, 0x70, 0xe4, 0x52, 0xf5, 0x54, 0x89,
-	0x0b, 0x27, 0x84, 0x76, 0xf9, 0x0f, 0xf8, 0x07, 0x50, 0xe6, 0x47, 0xd6, 0x71, 0x6c, 0x27, 0x59,
-	0xf5, 0xe6, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0x9e, 0x33, 0xfe, 0x4e, 0xe0, 0x46, 0x87, 0xf6, 0x68,
-	0x18, 0xb9, 0x04, 0x0f, 0x1c, 0x1b, 0x8f, 0xf6, 0xf0, 0x60, 0x48, 0x83, 0xb1, 0xd5, 0x0f, 0x58,
-	0xc4, 0xd0, 0x65, 0x15, 0xb4, 0x06, 0x8e, 0x6d, 0x8d, 0xf6, 0xf4, 0x37, 0x92, 0xd9, 0x0e, 0xf5,
-	0x69, 0xe8, 0x86, 0x22, 0x5f, 0x9f, 0x13, 0x8b, 0xc6, 0x7d, 0xaa, 0x82, 0x37, 0x1d, 0xc6, 0x9c,
-	0x1e, 0xc5, 0xa4, 0xef, 0x62, 0xe2, 0xfb, 0x2c, 0x22, 0x91, 0xcb, 0x7c, 0x15, 0xad, 0x38, 0xcc,
-	0x61, 0xfc, 0x11, 0x4f, 0x9e, 0xe4, 0xee, 0x7a, 0x87, 0x85, 0x1e, 0x0b, 0x8f, 0x44, 0x40, 0x2c,
-	0x54, 0x48, 0xca, 0xf1, 0x95, 0x3d, 0x7c, 0x86, 0x89, 0x2f, 0xb1, 0xcd, 0x0a, 0xa0, 0xcf, 0x26,
-	0x53, 0x3c, 0x21, 0x01, 0xf1, 0xc2, 0x36, 0x1d, 0x0c, 0x69, 0x18, 0x99, 0x8f, 0xa1, 0x3c, 0xb3,
-	0x1b, 0xf6, 0x99, 0x1f, 0x52, 0x74, 0x17, 0x8a, 0x7d, 0xbe, 0x73, 0x5d, 0xdb, 0xd4, 0xb6, 0x4b,
-	0xcd, 0x35, 0x2b, 0x31, 0xb4, 0x25, 0x0a, 0x5a, 0x17, 0x9e, 0xff, 0xbd, 0x51, 0x68, 0xcb, 0x64,
-	0xf3, 0x3d, 0xd8, 0xe2, 0x6a, 0x87, 0x51, 0x44, 0x43, 0x31, 0x8a, 0x6c, 0xd4, 0x1a, 0x7f, 0xc2,
-	0xfc, 0x0e, 0x95, 0x2b, 0x54, 0x81, 0x8b, 0xfe, 0x64, 0xcd, 0xe5, 0x2f, 0xb4, 0xc5, 0xc2, 0x1c,
-	0x43, 0x63, 0x51, 0xb9, 0xe4, 0xfb, 0x14, 0x4a, 0xe4, 0x2c, 0x49, 0x42, 0x56, 0x2c, 0x31, 0xbd,
-	0xa5, 0xa6, 0xb7, 0x0e, 0xfd, 0x71, 0x6b, 0xed, 0xc5, 0xaf, 0xbb, 0xe5, 0x79, 0xc5, 0x8f, 0xda,
-	0x71, 0x05, 0xb3, 0x0e, 0x26, 0x6f, 0xfd, 0x98, 0x4c, 0xf6, 0x62, 0xe9, 0x71, 0x6c, 0xf3, 0x3e,
-	0xd4, 0x72, 0xb3, 0x24, 0x5d, 0xfa, 0x74, 0x0d, 0xa8, 0xf3, 0xe2, 0x87, 0x24, 0xe8, 0xb9, 0x39,
-	0x4d, 0xd4, 0x4b, 0xcc, 0xce, 0xcb, 0x6d, 0xd3, 0x82, 0x37, 0x63, 0x8c, 0x4f, 0x49, 0x2f, 0xa4,
-	0x91, 0x7a, 0x8b, 0xf4, 0x19, 0x0b, 0xe8, 0x12, 0x3f, 0xc4, 0x97, 0xb0, 0xb3, 0x94, 0x86, 0x04,
-	0xc1, 0x50, 0x1c, 0xf1, 0x9c, 0xcc, 0xd3, 0x22, 0x25, 0x64, 0x9a, 0x59, 0x83, 0x5b, 0x31, 0xfd,
-	0xcf, 0x7d, 0x9b, 0xf9, 0x5d, 0xd7, 0x77, 0x1e, 0x51, 0xd7, 0x39, 0x56, 0x8d, 0xcc, 0x07, 0x33,
-	0x3f, 0xc9, 0x5c, 0x92, 0xec, 0x5d, 0x85, 0xe2, 0x31, 0xdf, 0x91, 0x13, 0xc8, 0x95, 0x69, 0xc2,
-	0x66, 0xac, 0xfa, 0x03, 0x12, 0x91, 0xf7, 0x99, 0xe7, 0xb9, 0x91, 0x47, 0xfd, 0x69, 0x07, 0x6f,
-	0x06, 0x23, 0x99, 0x23, 0x1b, 0x3c, 0x82, 0xcb, 0x5d, 0x12, 0x91, 0xa3, 0xce, 0x34, 0x24, 0xa7,
-	0xdc, 0x98, 0x9b, 0x32, 0xa1, 0x70, 0xa9, 0x3b, 0xb3, 0x36, 0x5b, 0xb0, 0xcd, 0xdb, 0x25, 0xd2,
-	0x88, 0xef, 0xd0, 0x0f, 0x59, 0x30, 0x33, 0x7c, 0xe6, 0x58, 0x43, 0xb8, 0xb3, 0x84, 0xc6, 0x2b,
-	0x47, 0x7f, 0x08, 0x55, 0x71, 0x26, 0x9f, 0x7e, 0x7c, 0xd8, 0xed, 0x06, 0x34, 0x54, 0x06, 0x82,
-	0x76, 0xe0, 0xea, 0x88, 0xf4, 0xdc, 0x2e, 0x89, 0x58, 0x70, 0x44, 0x44, 0x8c, 0x77, 0x79, 0xbd,
-	0x7d, 0x65, 0x1a, 0x90, 0x35, 0xe6, 0x3d, 0x58, 0x9b, 0x93, 0x91, 0xac, 0x1b, 0x50, 0xa2, 0x23,
-	0x2f, 0xa1, 0x00, 0x74, 0xe4, 0xc9, 0xc4, 0xe6, 0x7f, 0x25, 0xb8, 0xc8, 0x8b, 0xd1, 0x57, 0x50,
-	0x14, 0xee, 0x83, 0x6a, 0x73, 0x73, 0xcc, 0x5b, 0x9c, 0x5e, 0xcf, 0x4f, 0x12, 0xfd, 0xcd, 0xea,
-	0xf7, 0x7f, 0xfe, 0xfb, 0xe3, 0x6b, 0x57, 0xd0, 0x25, 0xe5, 0xd2, 0xc2, 0xd2, 0xd0, 0xef, 0x1a,
-	0xac, 0x67, 0xfa, 0x11, 0x3a, 0x48, 0xd7, 0x5e, 0xe4, 0x7f, 0xfa, 0x3b, 0x2b, 0xd7, 0x49, 0xcc,
-	0x5d, 0x8e, 0x79, 0x1b, 0x6d, 0x29, 0xcc, 0x98, 0x89, 0x85, 0x38, 0x10, 0x45, 0x21, 0xfe, 0x86,
-	0x7f, 0xc7, 0xdf, 0xa1, 0x5f, 0x34, 0xa8, 0xa6, 0x9b, 0x15, 0xda, 0x4f, 0x47, 0xc8, 0x35, 0x40,
-	0xfd, 0xed, 0xd5, 0x8a, 0x24, 0xf4, 0x1d, 0x0e, 0x5d, 0x43, 0xb7, 0x52, 0xa1, 0x39, 0x2a, 0xee,
-	0x71, 0x09, 0xf4, 0x9b, 0x06, 0xd7, 0xb3, 0x8c, 0x0f, 0xdd, 0x4d, 0xef, 0xbe, 0xc0, 0x50, 0xf5,
-	0x83, 0x55, 0xcb, 0x24, 0xf6, 0x0e, 0xc7, 0xde, 0x42, 0xb5, 0x1c, 0x6c, 0x2a, 0x45, 0xd0, 0x0b,
-	0x0d, 0x8c, 0x7c, 0xbb, 0x44, 0xf7, 0xf3, 0x5e, 0xde, 0x02, 0xa3, 0xd6, 0x1f, 0x9c, 0xaf, 0x38,
-	0xeb, 0xd8, 0x08, 0x23, 0x56, 0x07, 0x06, 0xdb, 0xbc, 0x66, 0x7a, 0x6c, 0x7e, 0xd2, 0xe0, 0x5a,
-	0xaa, 0xed, 0xa2, 0x66, 0x1e, 0x46, 0xba, 0x91, 0xeb, 0xfb, 0x2b, 0xd5, 0x48, 0xe2, 0x75, 0x4e,
-	0x5c, 0x46, 0x57, 0x15, 0xf1, 0x50, 0x25, 0xa2, 0x3f, 0x34, 0xb8, 0x99, 0xe7, 0x7f, 0xe8, 0xdd,
-	0xf4, 0x86, 0x4b, 0xf8, 0xae, 0x7e, 0xef, 0x3c, 0xa5, 0x12, 0xf9, 0x2d, 0x8e, 0xdc, 0x40, 0x75,
-	0x85, 0x9c, 0x30, 0x5f, 0x1c, 0x4c, 0xea, 0xb0, 0x70, 0x72, 0xf4, 0xb3, 0x06, 0x95, 0xb4, 0x8b,
-	0x07, 0xed, 0xe5, 0xbd, 0xae, 0xd4, 0x8b, 0x4c, 0x6f, 0xae, 0x52, 0x22, 0x69, 0x1b, 0x9c, 0x76,
-	0x13, 0x19, 0x59, 0xb4, 0xf2, 0x8b, 0xfc, 0x16, 0xe0, 0xcc, 0xae, 0xd1, 0xed, 0x8c, 0x6f, 0x29,
-	0x79, 0x2f, 0xe8, 0xdb, 0x8b, 0x13, 0x25, 0xc8, 0x0d, 0x0e, 0x72, 0x0d, 0x95, 0x15, 0x48, 0xec,
-	0x1e, 0x68, 0x3d, 0x79, 0x7e, 0x62, 0x68, 0x2f, 0x4f, 0x0c, 0xed, 0x9f, 0x13, 0x43, 0xfb, 0xe1,
-	0xd4, 0x28, 0xbc, 0x3c, 0x35, 0x0a, 0x7f, 0x9d, 0x1a, 0x85, 0x2f, 0x0e, 0x1c, 0x37, 0x3a, 0x1e,
-	0xda, 0x56, 0x87, 0x79, 0x58, 0xb5, 0x62, 0x81, 0x33, 0x7d, 0xde, 0x25, 0xfd, 0x3e, 0xfe, 0x1a,
-	0xdb, 0x3d, 0x66, 0x87, 0x51, 0x40, 0x89, 0x27, 0xfe, 0x77, 0xdb, 0x45, 0xfe, 0xef, 0x70, 0xff,
-	0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x42, 0x6c, 0x12, 0x89, 0xe4, 0x0b, 0x00, 0x00,
+	// 1252 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xa6, 0x6d, 0x44, 0x5f, 0xaa, 0x26, 0x99, 0xa4, 0xf9, 0xd8, 0x34, 0x4e, 0xb2, 0xf9,
+	0x4e, 0x9a, 0xdd, 0x3a, 0xa1, 0x41, 0x34, 0xe5, 0x10, 0x43, 0x4b, 0x91, 0x0a, 0xa4, 0x46, 0xf4,
+	0xc0, 0x81, 0x68, 0xd6, 0x9e, 0x6e, 0x56, 0xd8, 0xbb, 0xce, 0xee, 0xda, 0xc2, 0x02, 0x2e, 0xfc,
+	0x05, 0x48, 0x1c, 0x39, 0x73, 0x43, 0x48, 0x48, 0xc0, 0x01, 0x38, 0x20, 0x15, 0xa9, 0x55, 0x4f,
+	0x95, 0xb8, 0x70, 0x42, 0x28, 0xe1, 0x0f, 0x41, 0x9e, 0x79, 0xeb, 0xec, 0xb7, 0xed, 0x28, 0x48,
+	0x70, 0xf3, 0xcc, 0xfb, 0xbd, 0xf7, 0x7e, 0xef, 0xcd, 0xec, 0x9b, 0x5f, 0x02, 0xd3, 0x25, 0x56,
+	0x61, 0xae, 0x67, 0x52, 0xed, 0xc8, 0xd0, 0xb5, 0x46, 0x5e, 0x3b, 0xaa, 0x33, 0xa7, 0xa9, 0xd6,
+	0x1c, 0xdb, 0xb3, 0xc9, 0x90, 0x6f, 0x54, 0x8f, 0x0c, 0x5d, 0x6d, 0xe4, 0xe5, 0x99, 0x28, 0xda,
+	0x60, 0x16, 0x73, 0x4d, 0x57, 0xe0, 0xe5, 0x58, 0x30, 0xaf, 0x59, 0x63, 0xbe, 0xf1, 0xba, 0x61,
+	0xdb, 0x46, 0x85, 0x69, 0xb4, 0x66, 0x6a, 0xd4, 0xb2, 0x6c, 0x8f, 0x7a, 0xa6, 0x6d, 0xf9, 0xd6,
+	0x31, 0xc3, 0x36, 0x6c, 0xfe, 0x53, 0x6b, 0xfd, 0xc2, 0xdd, 0xa9, 0x92, 0xed, 0x56, 0x6d, 0xf7,
+	0x40, 0x18, 0xc4, 0xc2, 0x37, 0x61, 0x38, 0xbe, 0xd2, 0xeb, 0x8f, 0x35, 0x6a, 0x21, 0x6d, 0x79,
+	0x5d, 0x00, 0x35, 0x9d, 0xba, 0x4c, 0xd4, 0xa3, 0x35, 0xf2, 0x3a, 0xf3, 0x68, 0x5e, 0xab, 0x51,
+	0xc3, 0xb4, 0x78, 0x62, 0x81, 0x55, 0xc6, 0x80, 0x3c, 0x6c, 0x21, 0xf6, 0xa9, 0x43, 0xab, 0x6e,
+	0x91, 0x1d, 0xd5, 0x99, 0xeb, 0x29, 0x0f, 0x60, 0x34, 0xb4, 0xeb, 0xd6, 0x6c, 0xcb, 0x65, 0xe4,
+	0x16, 0x0c, 0xd4, 0xf8, 0xce, 0xa4, 0x34, 0x27, 0xad, 0x0e, 0x6e, 0x4d, 0xa8, 0x91, 0x06, 0xa9,
+	0xc2, 0xa1, 0x70, 0xf1, 0xd9, 0x9f, 0xb3, 0x7d, 0x45, 0x04, 0x2b, 0xaf, 0xc1, 0x12, 0x8f, 0xb6,
+	0xe7, 0x79, 0xcc, 0x15, 0x65, 0x63, 0xa2, 0x42, 0xf3, 0x1d, 0xdb, 0x2a, 0x31, 0x5c, 0x91, 0x31,
+	0xb8, 0x64, 0xb5, 0xd6, 0x3c, 0xfc, 0xc5, 0xa2, 0x58, 0x28, 0x4d, 0x58, 0xee, 0xe4, 0x8e, 0xfc,
+	0xde, 0x85, 0x41, 0x7a, 0x0a, 0x42, 0x92, 0x63, 0xaa, 0xe8, 0x94, 0xea, 0x77, 0x4a, 0xdd, 0xb3,
+	0x9a, 0x85, 0x89, 0xe7, 0xdf, 0x6f, 0x8e, 0xc6, 0x23, 0xbe, 0x55, 0x0c, 0x46, 0x50, 0xbe, 0x91,
+	0x60, 0x31, 0x9a, 0xdb, 0xf5, 0xb3, 0x52, 0xcb, 0x68, 0x33, 0x9f, 0x85, 0x41, 0x9d, 0x19, 0xa6,
+	0x75, 0x10, 0xe4, 0x0f, 0x7c, 0x8b, 0x83, 0xc9, 0x34, 0x5c, 0x66, 0x56, 0x19, 0xcd, 0xfd, 0xdc,
+	0xfc, 0x12, 0xb3, 0xca, 0xc2, 0x78, 0x0f, 0xe0, 0xf4, 0x60, 0x26, 0x2f, 0x70, 0xda, 0xcb, 0x2a,
+	0x1e, 0x77, 0xeb, 0x14, 0x55, 0x71, 0x2b, 0xf1, 0x14, 0xd5, 0x7d, 0xda, 0xce, 0x5c, 0x0c, 0x78,
+	0x2a, 0x4f, 0xa4, 0x78, 0xa7, 0x23, 0x74, 0xb1, 0x53, 0x0f, 0xe1, 0x4a, 0xa0, 0xce, 0xd6, 0x79,
+	0x5e, 0xe8, 0xbd, 0x55, 0xa1, 0x10, 0xe4, 0xcd, 0x50, 0x11, 0xfd, 0xbc, 0x88, 0x95, 0x8e, 0x45,
+	0x08, 0x3e, 0xa1, 0x2a, 0xbe, 0x4b, 0xae, 0xe2, 0x3e, 0x33, 0x8d, 0x43, 0x2f, 0xd4, 0xf5, 0x79,
+	0xb8, 0x22, 0xba, 0x7e, 0xc8, 0x6d, 0xd8, 0x76, 0x71, 0x12, 0x02, 0x4e, 0x66, 0x00, 0x5a, 0x7d,
+	0x47, 0x80, 0x68, 0x7c, 0xeb, 0x24, 0xd0, 0x7c, 0x5e, 0x9d, 0xff, 0x4d, 0x8a, 0x5f, 0xd2, 0x28,
+	0xe7, 0xff, 0x41, 0xeb, 0xf7, 0x20, 0x17, 0xab, 0xe2, 0x3d, 0xcf, 0x61, 0xb4, 0x1a, 0xb8, 0xe8,
+	0xae, 0x47, 0x1d, 0x2f, 0x7c, 0xd1, 0xf9, 0x16, 0xbf, 0x66, 0x8a, 0x03, 0xb3, 0xa9, 0x21, 0xfe,
+	0xad, 0xcf, 0x74, 0x11, 0x14, 0x9e, 0xf3, 0x01, 0x6d, 0xed, 0x05, 0xe0, 0xc1, 0xe9, 0xa2, 0xec,
+	0xc2, 0x42, 0x26, 0x0a, 0xd9, 0x25, 0x0f, 0xa1, 0x65, 0x1c, 0x04, 0x77, 0xa9, 0x53, 0x31, 0x33,
+	0x92, 0xf8, 0xb3, 0x2e, 0x1d, 0x97, 0x99, 0xa6, 0x00, 0xeb, 0x01, 0x8e, 0x8f, 0x68, 0xc5, 0x65,
+	0x9e, 0x3f, 0xec, 0xd8, 0x63, 0xdb, 0x61, 0x5d, 0xcc, 0xcb, 0x0f, 0x61, 0xa3, 0xab, 0x18, 0x48,
+	0x44, 0x83, 0x81, 0x06, 0xc7, 0xa4, 0x0e, 0x75, 0x0c, 0x81, 0x30, 0x65, 0x01, 0xe6, 0x03, 0xf1,
+	0xdf, 0xb7, 0x74, 0xdb, 0x2a, 0x9b, 0x96, 0x81, 0x77, 0x1d, 0xfb, 0x70, 0x27, 0x74, 0x24, 0x31,
+	0x10, 0xe6, 0x1e, 0x87, 0x81, 0xd0, 0xa7, 0x8b, 0x2b, 0x45, 0x81, 0xb9, 0x80, 0xf7, 0x1b, 0xd4,
+	0xa3, 0xaf, 0xdb, 0xd5, 0xaa, 0xe9, 0x55, 0x99, 0xd5, 0xce, 0x50, 0x0d, 0xd1, 0x88, 0x62, 0x30,
+	0xc1, 0x7d, 0x18, 0x2a, 0x53, 0x8f, 0x1e, 0x94, 0xda, 0x26, 0xac, 0x72, 0x36, 0x56, 0x65, 0x24,
+	0xc2, 0xd5, 0x72, 0x68, 0xad, 0x14, 0x60, 0x95, 0xa7, 0x8b, 0xc0, 0x5a, 0x9f, 0xf6, 0x3d, 0xdb,
+	0x09, 0x15, 0x9f, 0x5a, 0x56, 0x1d, 0xd6, 0xba, 0x88, 0x71, 0xee, 0xd4, 0x7f, 0x90, 0x12, 0xf3,
+	0xba, 0xa7, 0x39, 0xff, 0x9b, 0x43, 0xf5, 0xa9, 0x84, 0x5f, 0x43, 0x07, 0xde, 0xd8, 0xb0, 0x7d,
+	0x18, 0x8e, 0x34, 0xcc, 0x1f, 0xae, 0x9d, 0x3a, 0x86, 0x7a, 0x65, 0x28, 0xdc, 0xb7, 0x73, 0x9c,
+	0xab, 0x77, 0x61, 0x5c, 0x4c, 0x85, 0x47, 0x6f, 0xef, 0x95, 0xcb, 0x0e, 0x73, 0x7d, 0xa5, 0x45,
+	0x36, 0x60, 0xa4, 0x41, 0x2b, 0x66, 0x99, 0x7a, 0xb6, 0x73, 0x40, 0x85, 0x8d, 0xb7, 0xfc, 0x72,
+	0x71, 0xb8, 0x6d, 0x40, 0x1f, 0xe5, 0x36, 0x4c, 0xc4, 0xc2, 0x60, 0xf1, 0xb3, 0x30, 0xc8, 0x1a,
+	0xd5, 0x48, 0x04, 0x60, 0x8d, 0x2a, 0x02, 0xb7, 0x7e, 0x1a, 0x81, 0x4b, 0xdc, 0x99, 0x7c, 0x04,
+	0x03, 0x42, 0xa6, 0x91, 0x85, 0x58, 0x5f, 0xe2, 0x5a, 0x50, 0x5e, 0xcc, 0x06, 0x89, 0xfc, 0xca,
+	0xf8, 0xe7, 0xbf, 0xff, 0xfd, 0x65, 0xff, 0x30, 0xb9, 0xea, 0x4b, 0x5f, 0xa1, 0xfd, 0xc8, 0xcf,
+	0x12, 0x4c, 0xa5, 0x0a, 0x37, 0xb2, 0x93, 0x1c, 0xbb, 0x93, 0x50, 0x94, 0x5f, 0xe9, 0xd9, 0x0f,
+	0x69, 0x6e, 0x72, 0x9a, 0x2b, 0x64, 0xc9, 0xa7, 0x19, 0x7c, 0x47, 0x35, 0x47, 0x38, 0xb9, 0xda,
+	0x27, 0x7c, 0x92, 0x7e, 0x46, 0xbe, 0x95, 0x60, 0x3c, 0xf9, 0xb9, 0x20, 0xdb, 0xc9, 0x14, 0x32,
+	0x9f, 0x20, 0xf9, 0xe5, 0xde, 0x9c, 0x90, 0xf4, 0x1a, 0x27, 0xbd, 0x40, 0xe6, 0x13, 0x49, 0x73,
+	0xaa, 0x5a, 0x85, 0x87, 0x20, 0x3f, 0x4a, 0x30, 0x99, 0xf6, 0xf4, 0x90, 0x5b, 0xc9, 0xd9, 0x3b,
+	0x3c, 0x69, 0xf2, 0x4e, 0xaf, 0x6e, 0x48, 0x7b, 0x83, 0xd3, 0x5e, 0x22, 0x0b, 0x19, 0xb4, 0x19,
+	0x06, 0x21, 0xbf, 0x48, 0x30, 0x99, 0xa6, 0x5a, 0xd3, 0x88, 0x77, 0x10, 0xe5, 0xf2, 0x4e, 0xaf,
+	0x6e, 0x48, 0x3c, 0xcf, 0x89, 0x6f, 0x90, 0xb5, 0xec, 0x4b, 0xe2, 0xb4, 0x9c, 0x44, 0x1d, 0xe4,
+	0xd7, 0xf0, 0x35, 0x0f, 0x4b, 0x3f, 0xd2, 0x15, 0x91, 0xf8, 0x28, 0xee, 0xe2, 0x9a, 0x27, 0x6b,
+	0x4c, 0x65, 0x8b, 0x57, 0x70, 0x83, 0xac, 0x77, 0x53, 0x81, 0x18, 0xe3, 0xa4, 0x09, 0x24, 0xae,
+	0xd9, 0x88, 0xd6, 0x99, 0x42, 0x48, 0x20, 0xca, 0x37, 0xbb, 0x77, 0x10, 0x64, 0x6f, 0x4a, 0xe4,
+	0xb9, 0x04, 0xb9, 0x6c, 0xb5, 0x42, 0x76, 0xb3, 0xbe, 0x9c, 0x0e, 0x3a, 0x49, 0xbe, 0x73, 0x36,
+	0xe7, 0xb4, 0x99, 0x21, 0x74, 0x90, 0xdf, 0x46, 0x4d, 0xe7, 0x3e, 0xed, 0x99, 0xf1, 0x95, 0x04,
+	0xd7, 0x12, 0x55, 0x0f, 0xd9, 0xca, 0xa2, 0x91, 0xac, 0xa3, 0xe4, 0xed, 0x9e, 0x7c, 0x90, 0xf1,
+	0x14, 0x67, 0x3c, 0x4a, 0x46, 0x7c, 0xc6, 0x75, 0x1f, 0x48, 0x9e, 0x48, 0x70, 0x3d, 0x4b, 0x7e,
+	0x90, 0x57, 0x93, 0x13, 0x76, 0x21, 0x7b, 0xe4, 0xdb, 0x67, 0x71, 0x45, 0xca, 0x37, 0x38, 0xe5,
+	0x65, 0xb2, 0xe8, 0x53, 0x8e, 0x3c, 0xe5, 0xe1, 0xbb, 0xfa, 0x54, 0x82, 0x99, 0x4c, 0x51, 0x40,
+	0xba, 0xe2, 0x92, 0xac, 0x80, 0xe4, 0xdd, 0x33, 0xf9, 0xa6, 0xdd, 0x96, 0xac, 0x42, 0x5c, 0xf2,
+	0xb5, 0x04, 0x63, 0x49, 0x0a, 0x96, 0xe4, 0xb3, 0x0e, 0x3e, 0x51, 0x11, 0xcb, 0x5b, 0xbd, 0xb8,
+	0x20, 0xdd, 0x65, 0x4e, 0x77, 0x8e, 0xe4, 0xd2, 0xe8, 0xe2, 0xc3, 0xf2, 0x29, 0xc0, 0xa9, 0xea,
+	0x20, 0x2b, 0x29, 0x4f, 0x42, 0x54, 0xde, 0xc8, 0xab, 0x9d, 0x81, 0x48, 0x64, 0x9a, 0x13, 0xb9,
+	0x46, 0x46, 0x7d, 0x22, 0x01, 0x39, 0x53, 0xd8, 0x7f, 0x76, 0x9c, 0x93, 0x5e, 0x1c, 0xe7, 0xa4,
+	0xbf, 0x8e, 0x73, 0xd2, 0x17, 0x27, 0xb9, 0xbe, 0x17, 0x27, 0xb9, 0xbe, 0x3f, 0x4e, 0x72, 0x7d,
+	0x1f, 0xec, 0x18, 0xa6, 0x77, 0x58, 0xd7, 0xd5, 0x92, 0x5d, 0xd5, 0xfc, 0x54, 0xb6, 0x63, 0xb4,
+	0x7f, 0x6f, 0xd2, 0x5a, 0x4d, 0xfb, 0x58, 0xd3, 0x2b, 0xb6, 0xee, 0xf2, 0xb1, 0x23, 0xfe, 0x27,
+	0xa7, 0x0f, 0xf0, 0x3f, 0x33, 0xb7, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xea, 0x1d, 0xf7, 0x6e,
+	0x00, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -927,6 +1412,17 @@ type QueryClient interface {
 	LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error)
 	// EarliestAttestationNonce queries the earliest attestation nonce.
 	EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error)
+	// AttestationsByNonceRange queries the attestation requests with a nonce in
+	// the provided range, in ascending nonce order.
+	AttestationsByNonceRange(ctx context.Context, in *QueryAttestationsByNonceRangeRequest, opts ...grpc.CallOption) (*QueryAttestationsByNonceRangeResponse, error)
+	// AttestationsByHeightRange queries the attestation requests related to the
+	// provided height range, in ascending nonce order: the valsets created at a
+	// height in the range and the data commitments committing to at least one
+	// block in the range.
+	AttestationsByHeightRange(ctx context.Context, in *QueryAttestationsByHeightRangeRequest, opts ...grpc.CallOption) (*QueryAttestationsByHeightRangeResponse, error)
+	// AttestationsStream streams the attestation requests starting from the
+	// provided nonce, then the new ones as they are created.
+	AttestationsStream(ctx context.Context, in *QueryAttestationsStreamRequest, opts ...grpc.CallOption) (Query_AttestationsStreamClient, error)
 	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
 	// And, even if the current nonce is a valset, it will return the previous
 	// one.
@@ -938,6 +1434,9 @@ type QueryClient interface {
 	// DataCommitmentRangeForHeight returns the data commitment window
 	// that includes the provided height
 	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
+	// DataCommitmentsForHeightRange returns the data commitments committing to at
+	// least one block in the provided height range, in ascending nonce order.
+	DataCommitmentsForHeightRange(ctx context.Context, in *QueryDataCommitmentsForHeightRangeRequest, opts ...grpc.CallOption) (*QueryDataCommitmentsForHeightRangeResponse, error)
 	// LatestDataCommitment returns the latest data commitment in store
 	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
 	// EVMAddress returns the evm address associated with a supplied
@@ -989,6 +1488,56 @@ func (c *queryClient) EarliestAttestationNonce(ctx context.Context, in *QueryEar
 	return out, nil
 }
 
+func (c *queryClient) AttestationsByNonceRange(ctx context.Context, in *QueryAttestationsByNonceRangeRequest, opts ...grpc.CallOption) (*QueryAttestationsByNonceRangeResponse, error) {
+	out := new(QueryAttestationsByNonceRangeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationsByNonceRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationsByHeightRange(ctx context.Context, in *QueryAttestationsByHeightRangeRequest, opts ...grpc.CallOption) (*QueryAttestationsByHeightRangeResponse, error) {
+	out := new(QueryAttestationsByHeightRangeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationsByHeightRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationsStream(ctx context.Context, in *QueryAttestationsStreamRequest, opts ...grpc.CallOption) (Query_AttestationsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/celestia.qgb.v1.Query/AttestationsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryAttestationsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_AttestationsStreamClient interface {
+	Recv() (*QueryAttestationsStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryAttestationsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryAttestationsStreamClient) Recv() (*QueryAttestationsStreamResponse, error) {
+	m := new(QueryAttestationsStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
 	out := new(QueryLatestValsetRequestBeforeNonceResponse)
 	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce", in, out, opts...)
@@ -1016,6 +1565,15 @@ func (c *queryClient) DataCommitmentRangeForHeight(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *queryClient) DataCommitmentsForHeightRange(ctx context.Context, in *QueryDataCommitmentsForHeightRangeRequest, opts ...grpc.CallOption) (*QueryDataCommitmentsForHeightRangeResponse, error) {
+	out := new(QueryDataCommitmentsForHeightRangeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/DataCommitmentsForHeightRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error) {
 	out := new(QueryLatestDataCommitmentResponse)
 	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestDataCommitment", in, out, opts...)
@@ -1045,6 +1603,17 @@ type QueryServer interface {
 	LatestAttestationNonce(context.Context, *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error)
 	// EarliestAttestationNonce queries the earliest attestation nonce.
 	EarliestAttestationNonce(context.Context, *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error)
+	// AttestationsByNonceRange queries the attestation requests with a nonce in
+	// the provided range, in ascending nonce order.
+	AttestationsByNonceRange(context.Context, *QueryAttestationsByNonceRangeRequest) (*QueryAttestationsByNonceRangeResponse, error)
+	// AttestationsByHeightRange queries the attestation requests related to the
+	// provided height range, in ascending nonce order: the valsets created at a
+	// height in the range and the data commitments committing to at least one
+	// block in the range.
+	AttestationsByHeightRange(context.Context, *QueryAttestationsByHeightRangeRequest) (*QueryAttestationsByHeightRangeResponse, error)
+	// AttestationsStream streams the attestation requests starting from the
+	// provided nonce, then the new ones as they are created.
+	AttestationsStream(*QueryAttestationsStreamRequest, Query_AttestationsStreamServer) error
 	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
 	// And, even if the current nonce is a valset, it will return the previous
 	// one.
@@ -1056,6 +1625,9 @@ type QueryServer interface {
 	// DataCommitmentRangeForHeight returns the data commitment window
 	// that includes the provided height
 	DataCommitmentRangeForHeight(context.Context, *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error)
+	// DataCommitmentsForHeightRange returns the data commitments committing to at
+	// least one block in the provided height range, in ascending nonce order.
+	DataCommitmentsForHeightRange(context.Context, *QueryDataCommitmentsForHeightRangeRequest) (*QueryDataCommitmentsForHeightRangeResponse, error)
 	// LatestDataCommitment returns the latest data commitment in store
 	LatestDataCommitment(context.Context, *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error)
 	// EVMAddress returns the evm address associated with a supplied
@@ -1079,6 +1651,15 @@ func (*UnimplementedQueryServer) LatestAttestationNonce(ctx context.Context, req
 func (*UnimplementedQueryServer) EarliestAttestationNonce(ctx context.Context, req *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestationNonce not implemented")
 }
+func (*UnimplementedQueryServer) AttestationsByNonceRange(ctx context.Context, req *QueryAttestationsByNonceRangeRequest) (*QueryAttestationsByNonceRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationsByNonceRange not implemented")
+}
+func (*UnimplementedQueryServer) AttestationsByHeightRange(ctx context.Context, req *QueryAttestationsByHeightRangeRequest) (*QueryAttestationsByHeightRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationsByHeightRange not implemented")
+}
+func (*UnimplementedQueryServer) AttestationsStream(req *QueryAttestationsStreamRequest, srv Query_AttestationsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AttestationsStream not implemented")
+}
 func (*UnimplementedQueryServer) LatestValsetRequestBeforeNonce(ctx context.Context, req *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestValsetRequestBeforeNonce not implemented")
 }
@@ -1088,6 +1669,9 @@ func (*UnimplementedQueryServer) LatestUnbondingHeight(ctx context.Context, req
 func (*UnimplementedQueryServer) DataCommitmentRangeForHeight(ctx context.Context, req *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentRangeForHeight not implemented")
 }
+func (*UnimplementedQueryServer) DataCommitmentsForHeightRange(ctx context.Context, req *QueryDataCommitmentsForHeightRangeRequest) (*QueryDataCommitmentsForHeightRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentsForHeightRange not implemented")
+}
 func (*UnimplementedQueryServer) LatestDataCommitment(ctx context.Context, req *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestDataCommitment not implemented")
 }
@@ -1171,6 +1755,63 @@ func _Query_EarliestAttestationNonce_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttestationsByNonceRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsByNonceRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationsByNonceRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/AttestationsByNonceRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationsByNonceRange(ctx, req.(*QueryAttestationsByNonceRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationsByHeightRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsByHeightRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationsByHeightRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/AttestationsByHeightRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationsByHeightRange(ctx, req.(*QueryAttestationsByHeightRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryAttestationsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).AttestationsStream(m, &queryAttestationsStreamServer{stream})
+}
+
+type Query_AttestationsStreamServer interface {
+	Send(*QueryAttestationsStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryAttestationsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryAttestationsStreamServer) Send(m *QueryAttestationsStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLatestValsetRequestBeforeNonceRequest)
 	if err := dec(in); err != nil {
@@ -1225,6 +1866,24 @@ func _Query_DataCommitmentRangeForHeight_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DataCommitmentsForHeightRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataCommitmentsForHeightRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataCommitmentsForHeightRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/DataCommitmentsForHeightRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataCommitmentsForHeightRange(ctx, req.(*QueryDataCommitmentsForHeightRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LatestDataCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLatestDataCommitmentRequest)
 	if err := dec(in); err != nil {
@@ -1281,6 +1940,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EarliestAttestationNonce",
 			Handler:    _Query_EarliestAttestationNonce_Handler,
 		},
+		{
+			MethodName: "AttestationsByNonceRange",
+			Handler:    _Query_AttestationsByNonceRange_Handler,
+		},
+		{
+			MethodName: "AttestationsByHeightRange",
+			Handler:    _Query_AttestationsByHeightRange_Handler,
+		},
 		{
 			MethodName: "LatestValsetRequestBeforeNonce",
 			Handler:    _Query_LatestValsetRequestBeforeNonce_Handler,
@@ -1293,6 +1960,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DataCommitmentRangeForHeight",
 			Handler:    _Query_DataCommitmentRangeForHeight_Handler,
 		},
+		{
+			MethodName: "DataCommitmentsForHeightRange",
+			Handler:    _Query_DataCommitmentsForHeightRange_Handler,
+		},
 		{
 			MethodName: "LatestDataCommitment",
 			Handler:    _Query_LatestDataCommitment_Handler,
@@ -1302,7 +1973,13 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_EVMAddress_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AttestationsStream",
+			Handler:       _Query_AttestationsStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "celestia/qgb/v1/query.proto",
 }
 
@@ -1425,7 +2102,7 @@ func (m *QueryAttestationRequestByNonceResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttestationsByNonceRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1435,20 +2112,42 @@ func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryLatestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttestationsByNonceRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLatestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttestationsByNonceRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BeginNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BeginNonce))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttestationsByNonceRangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1458,25 +2157,46 @@ func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryLatestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttestationsByNonceRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLatestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttestationsByNonceRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttestationsByHeightRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1486,20 +2206,42 @@ func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryEarliestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttestationsByHeightRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEarliestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttestationsByHeightRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BeginHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BeginHeight))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEarliestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttestationsByHeightRangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1509,25 +2251,46 @@ func (m *QueryEarliestAttestationNonceResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryEarliestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttestationsByHeightRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEarliestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttestationsByHeightRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttestationsStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1537,12 +2300,177 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttestationsStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttestationsStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarliestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarliestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarliestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1762,6 +2690,100 @@ func (m *QueryDataCommitmentRangeForHeightResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDataCommitmentsForHeightRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataCommitmentsForHeightRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataCommitmentsForHeightRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BeginHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BeginHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataCommitmentsForHeightRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataCommitmentsForHeightRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataCommitmentsForHeightRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DataCommitments) > 0 {
+		for iNdEx := len(m.DataCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryEVMAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1878,68 +2900,169 @@ func (m *QueryAttestationRequestByNonceResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
+func (m *QueryAttestationsByNonceRangeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.BeginNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BeginNonce))
+	}
+	if m.EndNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EndNonce))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
+func (m *QueryAttestationsByNonceRangeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
+func (m *QueryAttestationsByHeightRangeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.BeginHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BeginHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
+func (m *QueryAttestationsByHeightRangeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
+func (m *QueryAttestationsStreamRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if m.StartNonce != 0 {
+		n += 1 + sovQuery(uint64(m.StartNonce))
 	}
 	return n
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Size() (n int) {
+func (m *QueryAttestationsStreamResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Valset != nil {
-		l = m.Valset.Size()
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valset != nil {
+		l = m.Valset.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -2013,6 +3136,44 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDataCommitmentsForHeightRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BeginHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataCommitmentsForHeightRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DataCommitments) > 0 {
+		for _, e := range m.DataCommitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryEVMAddressRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2105,28 +3266,668 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &types.Any{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationsByNonceRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsByNonceRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsByNonceRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginNonce", wireType)
+			}
+			m.BeginNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndNonce", wireType)
+			}
+			m.EndNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationsByNonceRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsByNonceRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsByNonceRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &types.Any{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationsByHeightRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsByHeightRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsByHeightRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginHeight", wireType)
+			}
+			m.BeginHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationsByHeightRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsByHeightRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsByHeightRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Attestations = append(m.Attestations, &types.Any{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2153,7 +3954,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2178,7 +3982,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2201,17 +4005,17 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartNonce", wireType)
 			}
-			m.Nonce = 0
+			m.StartNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2221,7 +4025,7 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.StartNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2247,7 +4051,7 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2270,10 +4074,10 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3136,6 +4940,250 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *QueryDataCommitmentsForHeightRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataCommitmentsForHeightRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataCommitmentsForHeightRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginHeight", wireType)
+			}
+			m.BeginHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataCommitmentsForHeightRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataCommitmentsForHeightRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataCommitmentsForHeightRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataCommitments = append(m.DataCommitments, DataCommitment{})
+			if err := m.DataCommitments[len(m.DataCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,9 +12,10 @@ import (
 )
 
 // ParamBlockList keeps track of parameters that cannot be changed by governance
-// proposals
+// proposals, and of the policies restricting the values of the ones that can.
 type ParamBlockList struct {
-	params map[string]bool
+	params   map[string]bool
+	policies map[string]ParamPolicy
 }
 
 // NewParamBlockList creates a new ParamBlockList that can be used to block gov
@@ -51,11 +52,15 @@ func (pbl ParamBlockList) handleParameterChangeProposal(
 	pk paramskeeper.Keeper,
 	p *proposal.ParameterChangeProposal,
 ) error {
-	// throw an error if any of the parameter changes are blocked
+	// throw an error if any of the parameter changes are blocked or not
+	// allowed by their policy
 	for _, c := range p.Changes {
 		if pbl.IsBlocked(c.Subspace, c.Key) {
 			return ErrBlockedParameter
 		}
+		if err := pbl.CheckPolicy(c.Subspace, c.Key, c.Value); err != nil {
+			return err
+		}
 	}
 
 	for _, c := range p.Changes {
@@ -0,0 +1,62 @@
+package paramfilter
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ParamPolicy restricts the values that governance proposals can set a
+// parameter to, on top of the validation done by the parameter's module.
+type ParamPolicy struct {
+	Subspace string
+	Key      string
+	// Validate returns an error if the proposed value, as provided in the
+	// proposal, is not allowed.
+	Validate func(value string) error
+}
+
+// NewParamPolicy creates a ParamPolicy that decodes the proposed value the
+// same way the params module does before passing it to validate.
+func NewParamPolicy[T any](subspace string, key string, validate func(T) error) ParamPolicy {
+	return ParamPolicy{
+		Subspace: subspace,
+		Key:      key,
+		Validate: func(value string) error {
+			var val T
+			if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(value), &val); err != nil {
+				return err
+			}
+			return validate(val)
+		},
+	}
+}
+
+// WithPolicies returns a copy of the ParamBlockList that also rejects the
+// proposals setting parameters to values not allowed by the provided
+// policies.
+func (pbl ParamBlockList) WithPolicies(policies ...ParamPolicy) ParamBlockList {
+	consolidatedPolicies := make(map[string]ParamPolicy, len(pbl.policies)+len(policies))
+	for key, policy := range pbl.policies {
+		consolidatedPolicies[key] = policy
+	}
+	for _, policy := range policies {
+		consolidatedPolicies[fmt.Sprintf("%s-%s", policy.Subspace, policy.Key)] = policy
+	}
+	pbl.policies = consolidatedPolicies
+	return pbl
+}
+
+// CheckPolicy returns an error if the value is not allowed by the policy of
+// the given parameter. Parameters without a policy accept any value.
+func (pbl ParamBlockList) CheckPolicy(subspace string, key string, value string) error {
+	policy, ok := pbl.policies[fmt.Sprintf("%s-%s", subspace, key)]
+	if !ok {
+		return nil
+	}
+	if err := policy.Validate(value); err != nil {
+		return sdkerrors.Wrapf(ErrParameterOutOfRange, "subspace: %s, key: %s, value: %s, err: %s", subspace, key, value, err.Error())
+	}
+	return nil
+}
@@ -2,9 +2,11 @@ package test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/app"
 	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	blobstreamtypes "github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
 	"github.com/celestiaorg/celestia-app/v2/x/paramfilter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
@@ -63,3 +65,38 @@ func TestParamFilter(t *testing.T) {
 func testProposal(changes ...proposal.ParamChange) *proposal.ParameterChangeProposal {
 	return proposal.NewParameterChangeProposal("title", "description", changes)
 }
+
+func TestParamPolicies(t *testing.T) {
+	app, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	handler := paramfilter.NewParamBlockList(app.BlockedParams()...).WithPolicies(app.ParamPolicies()...).GovHandler(app.ParamsKeeper)
+	ctx := sdk.NewContext(app.CommitMultiStore(), types.Header{}, false, tmlog.NewNopLogger())
+
+	expiryTimeKey := string(blobstreamtypes.ParamsStoreKeyAttestationExpiryTime)
+	thresholdKey := string(blobstreamtypes.ParamsStoreKeySignificantPowerDifferenceThreshold)
+
+	// two weeks and 10%
+	err := handler(ctx, testProposal(
+		proposal.NewParamChange(blobstreamtypes.ModuleName, expiryTimeKey, `"1209600000000000"`),
+		proposal.NewParamChange(blobstreamtypes.ModuleName, thresholdKey, `"0.100000000000000000"`),
+	))
+	require.NoError(t, err)
+	require.Equal(t, 14*24*time.Hour, app.BlobstreamKeeper.GetAttestationExpiryTimeParam(ctx))
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), app.BlobstreamKeeper.GetSignificantPowerDifferenceThresholdParam(ctx))
+
+	for _, change := range []proposal.ParamChange{
+		// one day
+		proposal.NewParamChange(blobstreamtypes.ModuleName, expiryTimeKey, `"86400000000000"`),
+		// one year
+		proposal.NewParamChange(blobstreamtypes.ModuleName, expiryTimeKey, `"31536000000000000"`),
+		proposal.NewParamChange(blobstreamtypes.ModuleName, thresholdKey, `"0.001000000000000000"`),
+		proposal.NewParamChange(blobstreamtypes.ModuleName, thresholdKey, `"0.500000000000000000"`),
+	} {
+		err = handler(ctx, testProposal(change))
+		require.ErrorIs(t, err, paramfilter.ErrParameterOutOfRange)
+	}
+
+	// the changes out of the allowed ranges were not applied
+	require.Equal(t, 14*24*time.Hour, app.BlobstreamKeeper.GetAttestationExpiryTimeParam(ctx))
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), app.BlobstreamKeeper.GetSignificantPowerDifferenceThresholdParam(ctx))
+
+}
@@ -10,6 +10,11 @@ const (
 	baseErrorCode = 91710
 )
 
-// ErrBlockedParameter is the error wrapped when a proposal to change a
-// blocked parameter is submitted.
-var ErrBlockedParameter = sdkerrors.Register(ModuleName, baseErrorCode, "parameter can not be modified")
+var (
+	// ErrBlockedParameter is the error wrapped when a proposal to change a
+	// blocked parameter is submitted.
+	ErrBlockedParameter = sdkerrors.Register(ModuleName, baseErrorCode, "parameter can not be modified")
+	// ErrParameterOutOfRange is the error wrapped when a proposal sets a
+	// parameter to a value not allowed by its policy.
+	ErrParameterOutOfRange = sdkerrors.Register(ModuleName, baseErrorCode+1, "parameter value is out of the allowed range")
+)